@@ -1,10 +1,11 @@
-module github.com/fionn/tflint-ruleset-okta
+module github.com/critical-mass/tflint-ruleset-okta
 
 go 1.25.1
 
 require (
 	github.com/hashicorp/hcl/v2 v2.24.0
 	github.com/terraform-linters/tflint-plugin-sdk v0.23.0
+	github.com/zclconf/go-cty v1.17.0
 )
 
 require (
@@ -24,7 +25,6 @@ require (
 	github.com/stretchr/testify v1.10.0 // indirect
 	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
-	github.com/zclconf/go-cty v1.17.0 // indirect
 	golang.org/x/mod v0.28.0 // indirect
 	golang.org/x/net v0.44.0 // indirect
 	golang.org/x/sync v0.17.0 // indirect
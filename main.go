@@ -9,7 +9,7 @@ import (
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		RuleSet: &tflint.BuiltinRuleSet{
+		RuleSet: &rules.RuleSet{BuiltinRuleSet: tflint.BuiltinRuleSet{
 			Name:    "okta",
 			Version: "1.0",
 			Rules: []tflint.Rule{
@@ -17,8 +17,101 @@ func main() {
 				rules.NewOktaAppOauthOmitSecretRule(),
 				rules.NewOktaAppOauthPlaintextRedirectURIRule(),
 				rules.NewOktaAppImplicitAuthenticationPolicyRule(),
-				rules.NewOktaGroupNamePrefixRule() 
+				rules.NewOktaGroupNamePrefixRule(),
+				rules.NewOktaGroupNameFormatRule(),
+				rules.NewOktaGroupNameEnvironmentSuffixRule(),
+				rules.NewOktaGroupNameLengthRule(),
+				rules.NewOktaGroupNameCharsetRule(),
+				rules.NewOktaGroupNameReservedWordsRule(),
+				rules.NewOktaNamingConventionRule(),
+				rules.NewOktaGroupDescriptionRequiredRule(),
+				rules.NewOktaGroupDescriptionFormatRule(),
+				rules.NewOktaGroupNameDuplicateRule(),
+				rules.NewOktaGroupCustomProfileAttributesRule(),
+				rules.NewOktaGroupRuleNamePrefixRule(),
+				rules.NewOktaGroupRuleExpressionRule(),
+				rules.NewOktaGroupRuleStatusRule(),
+				rules.NewOktaGroupRuleBroadExpressionRule(),
+				rules.NewOktaGroupOrphanRule(),
+				rules.NewOktaDeprecatedSkipArgumentsRule(),
+				rules.NewOktaAppOauthNoImplicitGrantRule(),
+				rules.NewOktaAppOauthPkceRequiredRule(),
+				rules.NewOktaAppOauthHTTPSRedirectURIRule(),
+				rules.NewOktaAppOauthLocalhostRedirectURIRule(),
+				rules.NewOktaAppOauthWildcardRedirectURIRule(),
+				rules.NewOktaAppOauthHardcodedSecretRule(),
+				rules.NewOktaAppOauthTokenEndpointAuthMethodRule(),
+				rules.NewOktaAppOauthRefreshTokenRotationRule(),
+				rules.NewOktaAppOauthLabelFormatRule(),
+				rules.NewOktaAppOauthGrantTypesRule(),
+				rules.NewOktaAppOauthHTTPSPostLogoutRedirectURIRule(),
+				rules.NewOktaAppOauthHTTPSURIRule(),
+				rules.NewOktaAppSamlAssertionSignedRule(),
+				rules.NewOktaAppSamlDigestAlgorithmRule(),
+				rules.NewOktaAppSamlSignatureAlgorithmRule(),
+				rules.NewOktaAppSamlHTTPSEndpointRule(),
+				rules.NewOktaAppSamlHonorForceAuthnRule(),
+				rules.NewOktaAppSamlSubjectNameIDFormatRule(),
+				rules.NewOktaAppBasicAuthRule(),
+				rules.NewOktaAppSharedCredentialsRule(),
+				rules.NewOktaAppSwaRevealPasswordRule(),
+				rules.NewOktaAppSecurePasswordStoreHardcodedSecretRule(),
+				rules.NewOktaAppStatusRule(),
+				rules.NewOktaAppUserAssignmentRule(),
+				rules.NewOktaAppUserHardcodedPasswordRule(),
+				rules.NewOktaAppBookmarkURLRule(),
+				rules.NewOktaAppProfileJSONRule(),
+				rules.NewOktaAppPrivilegedSelfServiceRule(),
+				rules.NewOktaAppLabelEnvironmentRule(),
+				rules.NewOktaAppOauthAutoKeyRotationRule(),
+				rules.NewOktaAppSamlKeyYearsValidRule(),
+				rules.NewOktaPolicyPasswordMinLengthRule(),
+				rules.NewOktaPolicyPasswordComplexityRule(),
+				rules.NewOktaPolicyPasswordHistoryCountRule(),
+				rules.NewOktaPolicyPasswordMaxAgeRule(),
+				rules.NewOktaPolicyPasswordMinAgeRule(),
+				rules.NewOktaPolicyPasswordLockoutRule(),
+				rules.NewOktaPolicyPasswordExcludeNamesRule(),
+				rules.NewOktaPolicyPasswordDictionaryLookupRule(),
+				rules.NewOktaPolicyPasswordPhoneRecoveryRule(),
+				rules.NewOktaPolicyPasswordQuestionRecoveryRule(),
+				rules.NewOktaPolicyPasswordRecoveryEmailTokenRule(),
+				rules.NewOktaPolicyRulePasswordSelfServiceRule(),
+				rules.NewOktaPolicyRuleSignonMfaRule(),
+				rules.NewOktaPolicyRuleSignonSessionLifetimeRule(),
+				rules.NewOktaPolicyRuleSignonSessionIdleRule(),
+				rules.NewOktaPolicyRuleSignonSessionPersistentRule(),
+				rules.NewOktaPolicyRuleSignonNetworkZoneRule(),
+				rules.NewOktaPolicyRuleSignonRiskRule(),
+				rules.NewOktaPolicyRuleSignonIdentityProviderRule(),
+				rules.NewOktaPolicyMfaOktaVerifyRule(),
+				rules.NewOktaPolicyMfaPhoneFactorRule(),
+				rules.NewOktaPolicyMfaEmailFactorRule(),
+				rules.NewOktaPolicyMfaQuestionFactorRule(),
+				rules.NewOktaPolicyMfaPhishingResistantRule(),
+				rules.NewOktaAppSignonPolicyRuleFactorModeRule(),
+				rules.NewOktaAppSignonPolicyRulePhishingResistantRule(),
+				rules.NewOktaAppSignonPolicyRuleReauthenticationRule(),
+				rules.NewOktaAppSignonPolicyRuleManagedDeviceRule(),
+				rules.NewOktaPolicyDeviceAssuranceMacOSRule(),
+				rules.NewOktaPolicyDeviceAssuranceWindowsRule(),
+				rules.NewOktaPolicyDeviceAssuranceIOSRule(),
+				rules.NewOktaPolicyDeviceAssuranceAndroidRule(),
+				rules.NewOktaPolicyRulePriorityRule(),
+				rules.NewOktaAppSignonPolicyRuleCatchAllRule(),
+				rules.NewOktaPolicyEveryoneGroupRule(),
+				rules.NewOktaPolicyRuleIdpDiscoveryRule(),
+				rules.NewOktaAuthServerAudiencesRule(),
+				rules.NewOktaAuthServerCredentialsRotationRule(),
+				rules.NewOktaAuthServerIssuerModeRule(),
+				rules.NewOktaAuthServerPolicyAllClientsRule(),
+				rules.NewOktaAuthServerPolicyRuleGrantTypeRule(),
+				rules.NewOktaAuthServerPolicyRuleAccessTokenLifetimeRule(),
+				rules.NewOktaAuthServerPolicyRuleRefreshTokenRule(),
+				rules.NewOktaAuthServerScopeNameRule(),
+				rules.NewOktaAuthServerClaimExpressionRule(),
+				rules.NewOktaAuthServerClaimGroupsFilterRule(),
 			},
-		},
+		}},
 	})
 }
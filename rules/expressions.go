@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// isStringLiteral reports whether the expression is a plain quoted string without interpolation.
+func isStringLiteral(expr hcl.Expression) bool {
+	template, ok := expr.(*hclsyntax.TemplateExpr)
+	return ok && template.IsStringLiteral()
+}
+
+// isHardcoded reports whether the expression's value is written out in the configuration,
+// rather than coming from a variable, another resource or a function call.
+func isHardcoded(expr hcl.Expression) bool {
+	if len(expr.Variables()) > 0 {
+		return false
+	}
+
+	node, ok := expr.(hclsyntax.Node)
+	if !ok {
+		return true
+	}
+
+	hardcoded := true
+	hclsyntax.VisitAll(node, func(node hclsyntax.Node) hcl.Diagnostics {
+		if _, ok := node.(*hclsyntax.FunctionCallExpr); ok {
+			hardcoded = false
+		}
+		return nil
+	})
+	return hardcoded
+}
+
+// checkHardcodedSecret emits an issue if the attribute holds a non-empty hardcoded secret.
+func checkHardcodedSecret(runner tflint.Runner, rule tflint.Rule, attribute *hclext.Attribute) error {
+	if !isHardcoded(attribute.Expr) {
+		return nil
+	}
+
+	return runner.EvaluateExpr(attribute.Expr, func(secret string) error {
+		if secret == "" {
+			return nil
+		}
+		return runner.EmitIssue(rule, fmt.Sprintf("%s must not be hardcoded, use a sensitive variable instead", attribute.Name), attribute.Range)
+	}, nil)
+}
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppBasicAuthRule struct {
+	tflint.DefaultRule
+	resourceType string
+}
+
+// Severity overrides the rule's severity, which is WARNING by default.
+type oktaAppBasicAuthRuleConfig struct {
+	Severity string `hclext:"severity,optional"`
+}
+
+func NewOktaAppBasicAuthRule() *OktaAppBasicAuthRule {
+	return &OktaAppBasicAuthRule{
+		resourceType: "okta_app_basic_auth",
+	}
+}
+
+func (r *OktaAppBasicAuthRule) Name() string {
+	return "okta_app_basic_auth"
+}
+
+func (r *OktaAppBasicAuthRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppBasicAuthRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppBasicAuthRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppBasicAuthRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	rule, err := withSeverity(r, config.Severity)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		err = runner.EmitIssue(rule, "Basic authentication applications send passwords to the application, use an OIDC or SAML application instead", resource.DefRange)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
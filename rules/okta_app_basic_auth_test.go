@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaAppBasicAuthRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "OIDC application",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "Example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Basic authentication application",
+			Content: `
+resource "okta_app_basic_auth" "example" {
+  label = "Example"
+  url   = "https://example.com/login"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppBasicAuthRule(),
+					Message: "Basic authentication applications send passwords to the application, use an OIDC or SAML application instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "Basic authentication applications are banned",
+			Content: `
+resource "okta_app_basic_auth" "example" {
+  label = "Example"
+}`,
+			Config: `
+rule "okta_app_basic_auth" {
+  enabled  = true
+  severity = "error"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAppBasicAuthRule(), severity: tflint.ERROR},
+					Message: "Basic authentication applications send passwords to the application, use an OIDC or SAML application instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppBasicAuthRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
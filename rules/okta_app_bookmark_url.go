@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppBookmarkURLRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// Domains lists the domains bookmarks may point to, including their subdomains. Any domain is allowed if it is empty.
+type oktaAppBookmarkURLRuleConfig struct {
+	Domains []string `hclext:"domains,optional"`
+}
+
+func NewOktaAppBookmarkURLRule() *OktaAppBookmarkURLRule {
+	return &OktaAppBookmarkURLRule{
+		resourceType:  "okta_app_bookmark",
+		attributeName: "url",
+	}
+}
+
+func (r *OktaAppBookmarkURLRule) Name() string {
+	return "okta_app_bookmark_url"
+}
+
+func (r *OktaAppBookmarkURLRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppBookmarkURLRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppBookmarkURLRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppBookmarkURLRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(bookmarkURL string) error {
+			insecure, err := isInsecureURI(bookmarkURL, nil)
+			if err != nil {
+				return err
+			}
+			if insecure {
+				return runner.EmitIssue(r, fmt.Sprintf("Bookmark URL %s must use HTTPS", bookmarkURL), attribute.Range)
+			}
+
+			uri, err := url.Parse(bookmarkURL)
+			if err != nil {
+				return err
+			}
+			if len(config.Domains) > 0 && !inDomains(uri.Hostname(), config.Domains) {
+				return runner.EmitIssue(r, fmt.Sprintf("Bookmark URL %s is not in one of the allowed domains: %s", bookmarkURL, strings.Join(config.Domains, ", ")), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inDomains reports whether the host is one of the domains or a subdomain of one.
+func inDomains(host string, domains []string) bool {
+	host = strings.ToLower(host)
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
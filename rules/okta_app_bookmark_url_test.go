@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppBookmarkURLRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Bookmark uses HTTPS",
+			Content: `
+resource "okta_app_bookmark" "example" {
+  url = "https://example.com"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Bookmark uses HTTP",
+			Content: `
+resource "okta_app_bookmark" "example" {
+  url = "http://example.com"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppBookmarkURLRule(),
+					Message: "Bookmark URL http://example.com must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "Bookmark is in an allowed domain",
+			Content: `
+resource "okta_app_bookmark" "example" {
+  url = "https://wiki.example.com/home"
+}`,
+			Config: `
+rule "okta_app_bookmark_url" {
+  enabled = true
+  domains = ["example.com"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Bookmark is not in an allowed domain",
+			Content: `
+resource "okta_app_bookmark" "example" {
+  url = "https://notexample.com"
+}`,
+			Config: `
+rule "okta_app_bookmark_url" {
+  enabled = true
+  domains = ["example.com", "example.org"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppBookmarkURLRule(),
+					Message: "Bookmark URL https://notexample.com is not in one of the allowed domains: example.com, example.org",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppBookmarkURLRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
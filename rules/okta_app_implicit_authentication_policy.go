@@ -10,14 +10,19 @@ import (
 
 type OktaAppImplicitAuthenticationPolicyRule struct {
 	tflint.DefaultRule
-	resourceTypes [2]string
+	resourceTypes []string
 	attributeName string
 	expected      bool
 }
 
+// ResourceTypes lists the application resource types which must specify a policy, and may use wildcards.
+type oktaAppImplicitAuthenticationPolicyRuleConfig struct {
+	ResourceTypes []string `hclext:"resource_types,optional"`
+}
+
 func NewOktaAppImplicitAuthenticationPolicyRule() *OktaAppImplicitAuthenticationPolicyRule {
 	return &OktaAppImplicitAuthenticationPolicyRule{
-		resourceTypes: [2]string{"okta_app_oauth", "okta_app_saml"},
+		resourceTypes: []string{"okta_app_oauth", "okta_app_saml"},
 		attributeName: "authentication_policy",
 		expected:      true,
 	}
@@ -38,24 +43,26 @@ func (r *OktaAppImplicitAuthenticationPolicyRule) Severity() tflint.Severity {
 func (r *OktaAppImplicitAuthenticationPolicyRule) Check(runner tflint.Runner) error {
 	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
 
-	issueMessage := "Application implicitly uses the default authentication policy"
+	config := oktaAppImplicitAuthenticationPolicyRuleConfig{ResourceTypes: r.resourceTypes}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
 
-	for _, resourceType := range r.resourceTypes {
+	issueMessage := "Application implicitly uses the default authentication policy"
 
-		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
-			Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
-		}, nil)
-		if err != nil {
-			return err
-		}
+	resources, err := getResourcesContent(runner, config.ResourceTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	})
+	if err != nil {
+		return err
+	}
 
-		for _, resource := range resources.Blocks {
-			_, exists := resource.Body.Attributes[r.attributeName]
-			if !exists {
-				err = runner.EmitIssue(r, issueMessage, resource.DefRange)
-				if err != nil {
-					return err
-				}
+	for _, resource := range resources {
+		_, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			err = runner.EmitIssue(r, issueMessage, resource.DefRange)
+			if err != nil {
+				return err
 			}
 		}
 	}
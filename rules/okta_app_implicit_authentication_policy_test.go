@@ -98,3 +98,71 @@ resource "okta_app_saml" "example" {
 		helper.AssertIssues(t, tc.Expected, runner.Issues)
 	}
 }
+
+func Test_OktaAppImplicitAuthenticationPolicy_ResourceTypes(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Unconfigured application type is not checked",
+			Content: `
+resource "okta_app_oauth" "example" {
+}`,
+			Config: `
+rule "okta_app_implicit_authentication_policy" {
+  enabled        = true
+  resource_types = ["okta_app_saml"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Configured application types are checked",
+			Content: `
+resource "okta_app_saml" "example" {
+}
+
+resource "okta_app_swa" "example" {
+}`,
+			Config: `
+rule "okta_app_implicit_authentication_policy" {
+  enabled        = true
+  resource_types = ["okta_app_saml", "okta_app_swa"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppImplicitAuthenticationPolicyRule(),
+					Message: "Application implicitly uses the default authentication policy",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 35},
+					},
+				},
+				{
+					Rule:    NewOktaAppImplicitAuthenticationPolicyRule(),
+					Message: "Application implicitly uses the default authentication policy",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 34},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppImplicitAuthenticationPolicyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppLabelEnvironmentRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+	attributeName string
+}
+
+// Prefix and Suffix mark application labels with their environment, such as "[DEV] ".
+// They are set in the configuration of each workspace.
+type oktaAppLabelEnvironmentRuleConfig struct {
+	Prefix string `hclext:"prefix,optional"`
+	Suffix string `hclext:"suffix,optional"`
+}
+
+func NewOktaAppLabelEnvironmentRule() *OktaAppLabelEnvironmentRule {
+	return &OktaAppLabelEnvironmentRule{
+		resourceTypes: []string{"okta_app_*"},
+		attributeName: "label",
+	}
+}
+
+func (r *OktaAppLabelEnvironmentRule) Name() string {
+	return "okta_app_label_environment"
+}
+
+func (r *OktaAppLabelEnvironmentRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaAppLabelEnvironmentRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppLabelEnvironmentRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppLabelEnvironmentRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if config.Prefix == "" && config.Suffix == "" {
+		return nil
+	}
+
+	resources, err := getResourcesContent(runner, r.resourceTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(label string) error {
+			if !strings.HasPrefix(label, config.Prefix) {
+				err := runner.EmitIssue(r, fmt.Sprintf("Application label %s must start with '%s'", label, config.Prefix), attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			if !strings.HasSuffix(label, config.Suffix) {
+				return runner.EmitIssue(r, fmt.Sprintf("Application label %s must end with '%s'", label, config.Suffix), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppLabelEnvironmentRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Nothing is configured",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "Example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Label has the environment prefix",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "[DEV] Example"
+}`,
+			Config: `
+rule "okta_app_label_environment" {
+  enabled = true
+  prefix  = "[DEV] "
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Labels lack the environment prefix",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "Example"
+}
+
+resource "okta_app_bookmark" "example" {
+  label = "[PROD] Example"
+}`,
+			Config: `
+rule "okta_app_label_environment" {
+  enabled = true
+  prefix  = "[DEV] "
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppLabelEnvironmentRule(),
+					Message: "Application label Example must start with '[DEV] '",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+				{
+					Rule:    NewOktaAppLabelEnvironmentRule(),
+					Message: "Application label [PROD] Example must start with '[DEV] '",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "Label lacks the environment suffix",
+			Content: `
+resource "okta_app_saml" "example" {
+  label = "Example"
+}`,
+			Config: `
+rule "okta_app_label_environment" {
+  enabled = true
+  suffix  = " (Staging)"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppLabelEnvironmentRule(),
+					Message: "Application label Example must end with ' (Staging)'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppLabelEnvironmentRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
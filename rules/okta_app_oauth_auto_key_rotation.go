@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthAutoKeyRotationRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeName  string
+	labelAttribute string
+}
+
+// Exempt lists the labels of applications whose integrations pin their keys.
+type oktaAppOauthAutoKeyRotationRuleConfig struct {
+	Exempt []string `hclext:"exempt,optional"`
+}
+
+func NewOktaAppOauthAutoKeyRotationRule() *OktaAppOauthAutoKeyRotationRule {
+	return &OktaAppOauthAutoKeyRotationRule{
+		resourceType:   "okta_app_oauth",
+		attributeName:  "auto_key_rotation",
+		labelAttribute: "label",
+	}
+}
+
+func (r *OktaAppOauthAutoKeyRotationRule) Name() string {
+	return "okta_app_oauth_auto_key_rotation"
+}
+
+func (r *OktaAppOauthAutoKeyRotationRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthAutoKeyRotationRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppOauthAutoKeyRotationRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppOauthAutoKeyRotationRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.labelAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		exempt := false
+		if labelAttribute, exists := resource.Body.Attributes[r.labelAttribute]; exists && len(config.Exempt) > 0 {
+			err := runner.EvaluateExpr(labelAttribute.Expr, func(label string) error {
+				exempt = slices.Contains(config.Exempt, label)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if exempt {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(autoKeyRotation bool) error {
+			if !autoKeyRotation {
+				return runner.EmitIssue(r, "OAuth application keys should be rotated automatically", attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
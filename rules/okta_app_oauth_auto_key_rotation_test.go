@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthAutoKeyRotationRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Keys are rotated",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label             = "Example"
+  auto_key_rotation = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Keys are not rotated",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label             = "Example"
+  auto_key_rotation = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthAutoKeyRotationRule(),
+					Message: "OAuth application keys should be rotated automatically",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Application pins its keys",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label             = "Legacy Integration"
+  auto_key_rotation = false
+}`,
+			Config: `
+rule "okta_app_oauth_auto_key_rotation" {
+  enabled = true
+  exempt  = ["Legacy Integration"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppOauthAutoKeyRotationRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// oauthAppGrantTypes maps each OAuth application type to the grant types it may use.
+var oauthAppGrantTypes = map[string][]string{
+	"service": {
+		"client_credentials",
+	},
+	"browser": {
+		"authorization_code",
+		"implicit",
+		"refresh_token",
+		"interaction_code",
+	},
+	"native": {
+		"authorization_code",
+		"implicit",
+		"password",
+		"refresh_token",
+		"interaction_code",
+		"urn:ietf:params:oauth:grant-type:device_code",
+		"urn:ietf:params:oauth:grant-type:token-exchange",
+	},
+	"web": {
+		"authorization_code",
+		"implicit",
+		"password",
+		"refresh_token",
+		"client_credentials",
+		"interaction_code",
+		"urn:ietf:params:oauth:grant-type:saml2-bearer",
+		"urn:ietf:params:oauth:grant-type:token-exchange",
+	},
+}
+
+type OktaAppOauthGrantTypesRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+func NewOktaAppOauthGrantTypesRule() *OktaAppOauthGrantTypesRule {
+	return &OktaAppOauthGrantTypesRule{
+		resourceType:  "okta_app_oauth",
+		attributeName: "grant_types",
+	}
+}
+
+func (r *OktaAppOauthGrantTypesRule) Name() string {
+	return "okta_app_oauth_grant_types"
+}
+
+func (r *OktaAppOauthGrantTypesRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthGrantTypesRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthGrantTypesRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		typeAttribute, typeExists := resource.Body.Attributes["type"]
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !typeExists || !exists {
+			continue
+		}
+
+		var allowed []string
+		appType := ""
+		err := runner.EvaluateExpr(typeAttribute.Expr, func(value string) error {
+			appType = value
+			allowed = oauthAppGrantTypes[value]
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if allowed == nil {
+			continue
+		}
+
+		err = runner.EvaluateExpr(attribute.Expr, func(grantTypes []string) error {
+			for _, grantType := range grantTypes {
+				if !slices.Contains(allowed, grantType) {
+					err := runner.EmitIssue(r, fmt.Sprintf("OAuth application of type %s cannot use the %s grant, only %s", appType, grantType, strings.Join(allowed, ", ")), attribute.Range)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthGrantTypesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Grant types match the application type",
+			Content: `
+resource "okta_app_oauth" "service" {
+  type        = "service"
+  grant_types = ["client_credentials"]
+}
+
+resource "okta_app_oauth" "browser" {
+  type        = "browser"
+  grant_types = ["authorization_code", "refresh_token"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Service application uses a user grant",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type        = "service"
+  grant_types = ["client_credentials", "authorization_code"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthGrantTypesRule(),
+					Message: "OAuth application of type service cannot use the authorization_code grant, only client_credentials",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 61},
+					},
+				},
+			},
+		},
+		{
+			Name: "Browser application uses the password grant",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type        = "browser"
+  grant_types = ["authorization_code", "password"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthGrantTypesRule(),
+					Message: "OAuth application of type browser cannot use the password grant, only authorization_code, implicit, refresh_token, interaction_code",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 51},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthGrantTypesRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthHardcodedSecretRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeNames []string
+}
+
+func NewOktaAppOauthHardcodedSecretRule() *OktaAppOauthHardcodedSecretRule {
+	return &OktaAppOauthHardcodedSecretRule{
+		resourceType:   "okta_app_oauth",
+		attributeNames: []string{"client_secret", "client_basic_secret"},
+	}
+}
+
+func (r *OktaAppOauthHardcodedSecretRule) Name() string {
+	return "okta_app_oauth_hardcoded_secret"
+}
+
+func (r *OktaAppOauthHardcodedSecretRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthHardcodedSecretRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthHardcodedSecretRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	attributes := []hclext.AttributeSchema{}
+	for _, attributeName := range r.attributeNames {
+		attributes = append(attributes, hclext.AttributeSchema{Name: attributeName})
+	}
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, attributeName := range r.attributeNames {
+			attribute, exists := resource.Body.Attributes[attributeName]
+			if !exists {
+				continue
+			}
+
+			if err := checkHardcodedSecret(runner, r, attribute); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
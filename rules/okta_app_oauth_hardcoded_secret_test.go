@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthHardcodedSecretRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Secret comes from a variable",
+			Content: `
+variable "client_secret" {
+  type      = string
+  sensitive = true
+  default   = "secret"
+}
+
+resource "okta_app_oauth" "example" {
+  client_secret = var.client_secret
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Secret is read from a file",
+			Content: `
+resource "okta_app_oauth" "example" {
+  client_basic_secret = sensitive(file("secret.txt"))
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Secret is hardcoded",
+			Content: `
+resource "okta_app_oauth" "example" {
+  client_secret       = "secret"
+  client_basic_secret = "basic-${"secret"}"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthHardcodedSecretRule(),
+					Message: "client_secret must not be hardcoded, use a sensitive variable instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 33},
+					},
+				},
+				{
+					Rule:    NewOktaAppOauthHardcodedSecretRule(),
+					Message: "client_basic_secret must not be hardcoded, use a sensitive variable instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 44},
+					},
+				},
+			},
+		},
+		{
+			Name: "Secret is empty",
+			Content: `
+resource "okta_app_oauth" "example" {
+  client_secret = ""
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppOauthHardcodedSecretRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// isInsecureURI reports whether the URI uses neither HTTPS nor one of the allowed schemes.
+func isInsecureURI(rawURI string, allowedSchemes []string) (bool, error) {
+	uri, err := url.Parse(rawURI)
+	if err != nil {
+		return false, err
+	}
+
+	return uri.Scheme != "https" && !slices.Contains(allowedSchemes, uri.Scheme), nil
+}
+
+type OktaAppOauthHTTPSRedirectURIRule struct {
+	tflint.DefaultRule
+	name          string
+	noun          string
+	resourceType  string
+	attributeName string
+}
+
+// AllowedSchemes lists custom URI schemes, such as those of mobile applications, which need not be HTTPS.
+type oktaAppOauthHTTPSRedirectURIRuleConfig struct {
+	AllowedSchemes []string `hclext:"allowed_schemes,optional"`
+}
+
+func NewOktaAppOauthHTTPSRedirectURIRule() *OktaAppOauthHTTPSRedirectURIRule {
+	return &OktaAppOauthHTTPSRedirectURIRule{
+		name:          "okta_app_oauth_https_redirect_uri",
+		noun:          "Redirect URI",
+		resourceType:  "okta_app_oauth",
+		attributeName: "redirect_uris",
+	}
+}
+
+func NewOktaAppOauthHTTPSPostLogoutRedirectURIRule() *OktaAppOauthHTTPSRedirectURIRule {
+	return &OktaAppOauthHTTPSRedirectURIRule{
+		name:          "okta_app_oauth_https_post_logout_redirect_uri",
+		noun:          "Post-logout redirect URI",
+		resourceType:  "okta_app_oauth",
+		attributeName: "post_logout_redirect_uris",
+	}
+}
+
+func (r *OktaAppOauthHTTPSRedirectURIRule) Name() string {
+	return r.name
+}
+
+func (r *OktaAppOauthHTTPSRedirectURIRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaAppOauthHTTPSRedirectURIRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthHTTPSRedirectURIRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppOauthHTTPSRedirectURIRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(uris []string) error {
+			for _, uri := range uris {
+				insecure, err := isInsecureURI(uri, config.AllowedSchemes)
+				if err != nil {
+					return err
+				}
+				if insecure {
+					err = runner.EmitIssue(r, fmt.Sprintf("%s %s must use HTTPS", r.noun, uri), attribute.Range)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthHTTPSRedirectURIRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Redirect URIs use HTTPS",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["https://example.com/callback"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Local redirect URI uses HTTP",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["https://example.com/callback", "http://localhost:8080/callback"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthHTTPSRedirectURIRule(),
+					Message: "Redirect URI http://localhost:8080/callback must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 85},
+					},
+				},
+			},
+		},
+		{
+			Name: "Mobile redirect URI scheme is not allowed",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["com.example.app:/callback"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthHTTPSRedirectURIRule(),
+					Message: "Redirect URI com.example.app:/callback must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "Mobile redirect URI scheme is allowed",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["com.example.app:/callback"]
+}`,
+			Config: `
+rule "okta_app_oauth_https_redirect_uri" {
+  enabled         = true
+  allowed_schemes = ["com.example.app"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppOauthHTTPSRedirectURIRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaAppOauthHTTPSPostLogoutRedirectURIRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Post-logout redirect URIs use HTTPS",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris             = ["http://localhost/callback"]
+  post_logout_redirect_uris = ["https://example.com/logout"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Post-logout redirect URI uses HTTP",
+			Content: `
+resource "okta_app_oauth" "example" {
+  post_logout_redirect_uris = ["http://example.com/logout"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthHTTPSPostLogoutRedirectURIRule(),
+					Message: "Post-logout redirect URI http://example.com/logout must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 60},
+					},
+				},
+			},
+		},
+		{
+			Name: "Mobile post-logout redirect URI scheme is allowed",
+			Content: `
+resource "okta_app_oauth" "example" {
+  post_logout_redirect_uris = ["com.example.app:/logout"]
+}`,
+			Config: `
+rule "okta_app_oauth_https_post_logout_redirect_uri" {
+  enabled         = true
+  allowed_schemes = ["com.example.app"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppOauthHTTPSPostLogoutRedirectURIRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthHTTPSURIRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeNames []string
+}
+
+func NewOktaAppOauthHTTPSURIRule() *OktaAppOauthHTTPSURIRule {
+	return &OktaAppOauthHTTPSURIRule{
+		resourceType:   "okta_app_oauth",
+		attributeNames: []string{"login_uri", "logo_uri"},
+	}
+}
+
+func (r *OktaAppOauthHTTPSURIRule) Name() string {
+	return "okta_app_oauth_https_uri"
+}
+
+func (r *OktaAppOauthHTTPSURIRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthHTTPSURIRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthHTTPSURIRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	attributes := []hclext.AttributeSchema{}
+	for _, attributeName := range r.attributeNames {
+		attributes = append(attributes, hclext.AttributeSchema{Name: attributeName})
+	}
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, attributeName := range r.attributeNames {
+			attribute, exists := resource.Body.Attributes[attributeName]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(uri string) error {
+				if uri == "" {
+					return nil
+				}
+				insecure, err := isInsecureURI(uri, nil)
+				if err != nil {
+					return err
+				}
+				if insecure {
+					return runner.EmitIssue(r, fmt.Sprintf("%s %s must use HTTPS", attributeName, uri), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
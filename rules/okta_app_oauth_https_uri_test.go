@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthHTTPSURIRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "URIs use HTTPS",
+			Content: `
+resource "okta_app_oauth" "example" {
+  login_uri = "https://example.com/login"
+  logo_uri  = "https://example.com/logo.png"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "URIs are not set",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "URIs use HTTP",
+			Content: `
+resource "okta_app_oauth" "example" {
+  login_uri = "http://dev.example.com/login"
+  logo_uri  = "http://example.com/logo.png"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthHTTPSURIRule(),
+					Message: "login_uri http://dev.example.com/login must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 45},
+					},
+				},
+				{
+					Rule:    NewOktaAppOauthHTTPSURIRule(),
+					Message: "logo_uri http://example.com/logo.png must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 44},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthHTTPSURIRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
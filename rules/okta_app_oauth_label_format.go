@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type OktaAppOauthLabelFormatRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// Prefix is prepended to literal labels by --fix. Format is a regular expression labels must match.
+type oktaAppOauthLabelFormatRuleConfig struct {
+	Prefix string `hclext:"prefix,optional"`
+	Format string `hclext:"format,optional"`
+}
+
+func NewOktaAppOauthLabelFormatRule() *OktaAppOauthLabelFormatRule {
+	return &OktaAppOauthLabelFormatRule{
+		resourceType:  "okta_app_oauth",
+		attributeName: "label",
+	}
+}
+
+func (r *OktaAppOauthLabelFormatRule) Name() string {
+	return "okta_app_oauth_label_format"
+}
+
+func (r *OktaAppOauthLabelFormatRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaAppOauthLabelFormatRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthLabelFormatRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppOauthLabelFormatRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var format *regexp.Regexp
+	if config.Format != "" {
+		var err error
+		format, err = regexp.Compile(config.Format)
+		if err != nil {
+			return fmt.Errorf("invalid format for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(label string) error {
+			if !strings.HasPrefix(label, config.Prefix) {
+				err = runner.EmitIssueWithFix(r, fmt.Sprintf("OAuth application label must start with '%s'", config.Prefix), attribute.Range, func(f tflint.Fixer) error {
+					if !isStringLiteral(attribute.Expr) {
+						return tflint.ErrFixNotSupported
+					}
+					return f.ReplaceText(attribute.Expr.Range(), f.ValueText(cty.StringVal(config.Prefix+label)))
+				})
+				if err != nil {
+					return err
+				}
+			}
+			if format != nil && !format.MatchString(label) {
+				err = runner.EmitIssue(r, fmt.Sprintf("OAuth application label %s does not match format %s", label, config.Format), attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
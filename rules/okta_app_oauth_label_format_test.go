@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthLabelFormatRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Nothing is configured",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "Example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Label matches the convention",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "Acme Example"
+}`,
+			Config: `
+rule "okta_app_oauth_label_format" {
+  enabled = true
+  prefix  = "Acme "
+  format  = "^[A-Z][A-Za-z ]+$"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Label breaks the convention",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "example_app"
+}`,
+			Config: `
+rule "okta_app_oauth_label_format" {
+  enabled = true
+  prefix  = "Acme "
+  format  = "^[A-Z][A-Za-z ]+$"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthLabelFormatRule(),
+					Message: "OAuth application label must start with 'Acme '",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+				{
+					Rule:    NewOktaAppOauthLabelFormatRule(),
+					Message: "OAuth application label example_app does not match format ^[A-Z][A-Za-z ]+$",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthLabelFormatRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaAppOauthLabelFormatRule_Fix(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected map[string]string
+	}{
+		{
+			Name: "Literal label is fixed",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "Example"
+}`,
+			Expected: map[string]string{
+				"resource.tf": `
+resource "okta_app_oauth" "example" {
+  label = "Acme Example"
+}`,
+			},
+		},
+		{
+			Name: "Interpolated label is not fixed",
+			Content: `
+variable "team" {
+  default = "Example"
+}
+
+resource "okta_app_oauth" "example" {
+  label = "${var.team} App"
+}`,
+			Expected: map[string]string{},
+		},
+	}
+
+	rule := NewOktaAppOauthLabelFormatRule()
+	config := `
+rule "okta_app_oauth_label_format" {
+  enabled = true
+  prefix  = "Acme "
+}`
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertChanges(t, tc.Expected, runner.Changes())
+	}
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthLocalhostRedirectURIRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	hosts         []string
+}
+
+// Hosts lists the host names which only resolve on a developer's machine.
+type oktaAppOauthLocalhostRedirectURIRuleConfig struct {
+	Hosts []string `hclext:"hosts,optional"`
+}
+
+func NewOktaAppOauthLocalhostRedirectURIRule() *OktaAppOauthLocalhostRedirectURIRule {
+	return &OktaAppOauthLocalhostRedirectURIRule{
+		resourceType:  "okta_app_oauth",
+		attributeName: "redirect_uris",
+		hosts:         []string{"localhost", "127.0.0.1"},
+	}
+}
+
+func (r *OktaAppOauthLocalhostRedirectURIRule) Name() string {
+	return "okta_app_oauth_localhost_redirect_uri"
+}
+
+func (r *OktaAppOauthLocalhostRedirectURIRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthLocalhostRedirectURIRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthLocalhostRedirectURIRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppOauthLocalhostRedirectURIRuleConfig{Hosts: r.hosts}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(redirectURIs []string) error {
+			for _, redirectURI := range redirectURIs {
+				uri, err := url.Parse(redirectURI)
+				if err != nil {
+					return err
+				}
+				if slices.Contains(config.Hosts, strings.ToLower(uri.Hostname())) {
+					err = runner.EmitIssue(r, fmt.Sprintf("Redirect URI %s points to a local host", redirectURI), attribute.Range)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthLocalhostRedirectURIRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Redirect URIs are remote",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["https://example.com/callback", "https://localhost.example.com/callback"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Redirect URIs are local",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["http://localhost:8080/callback", "http://127.0.0.1/callback"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthLocalhostRedirectURIRule(),
+					Message: "Redirect URI http://localhost:8080/callback points to a local host",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 82},
+					},
+				},
+				{
+					Rule:    NewOktaAppOauthLocalhostRedirectURIRule(),
+					Message: "Redirect URI http://127.0.0.1/callback points to a local host",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 82},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured hosts",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["http://localhost:8080/callback", "https://dev.internal/callback"]
+}`,
+			Config: `
+rule "okta_app_oauth_localhost_redirect_uri" {
+  enabled = true
+  hosts   = ["dev.internal"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthLocalhostRedirectURIRule(),
+					Message: "Redirect URI https://dev.internal/callback points to a local host",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 86},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthLocalhostRedirectURIRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
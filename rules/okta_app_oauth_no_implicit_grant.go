@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthNoImplicitGrantRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+func NewOktaAppOauthNoImplicitGrantRule() *OktaAppOauthNoImplicitGrantRule {
+	return &OktaAppOauthNoImplicitGrantRule{
+		resourceType:  "okta_app_oauth",
+		attributeName: "grant_types",
+	}
+}
+
+func (r *OktaAppOauthNoImplicitGrantRule) Name() string {
+	return "okta_app_oauth_no_implicit_grant"
+}
+
+func (r *OktaAppOauthNoImplicitGrantRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthNoImplicitGrantRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthNoImplicitGrantRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(grantTypes []string) error {
+			if slices.Contains(grantTypes, "implicit") {
+				err = runner.EmitIssue(r, "OAuth application should not use the implicit grant", attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
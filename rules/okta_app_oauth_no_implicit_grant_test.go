@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthNoImplicitGrantRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Grant types exclude implicit",
+			Content: `
+resource "okta_app_oauth" "example" {
+  grant_types = ["authorization_code", "refresh_token"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Grant types include implicit",
+			Content: `
+resource "okta_app_oauth" "example" {
+  grant_types = ["authorization_code", "implicit"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthNoImplicitGrantRule(),
+					Message: "OAuth application should not use the implicit grant",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 51},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthNoImplicitGrantRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
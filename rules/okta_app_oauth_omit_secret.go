@@ -15,6 +15,11 @@ type OktaAppOauthOmitSecretRule struct {
 	expected      bool
 }
 
+// Severity overrides the rule's severity, which is WARNING by default.
+type oktaAppOauthOmitSecretRuleConfig struct {
+	Severity string `hclext:"severity,optional"`
+}
+
 func NewOktaAppOauthOmitSecretRule() *OktaAppOauthOmitSecretRule {
 	return &OktaAppOauthOmitSecretRule{
 		resourceType:  "okta_app_oauth",
@@ -38,7 +43,17 @@ func (r *OktaAppOauthOmitSecretRule) Severity() tflint.Severity {
 func (r *OktaAppOauthOmitSecretRule) Check(runner tflint.Runner) error {
 	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
 
-	issueMessage := "OAuth application secret should be omitted"
+	config := oktaAppOauthOmitSecretRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	rule, err := withSeverity(r, config.Severity)
+	if err != nil {
+		return err
+	}
+
+	issueMessage := "OAuth application secret should be omitted, otherwise it is stored in Terraform state"
 
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
@@ -50,7 +65,7 @@ func (r *OktaAppOauthOmitSecretRule) Check(runner tflint.Runner) error {
 	for _, resource := range resources.Blocks {
 		attribute, exists := resource.Body.Attributes[r.attributeName]
 		if !exists {
-			err = runner.EmitIssue(r, issueMessage, resource.DefRange)
+			err = runner.EmitIssue(rule, issueMessage, resource.DefRange)
 			if err != nil {
 				return err
 			}
@@ -59,7 +74,7 @@ func (r *OktaAppOauthOmitSecretRule) Check(runner tflint.Runner) error {
 
 		err := runner.EvaluateExpr(attribute.Expr, func(omitSecret bool) error {
 			if !omitSecret {
-				err = runner.EmitIssue(r, issueMessage, attribute.Range)
+				err = runner.EmitIssue(rule, issueMessage, attribute.Range)
 				if err != nil {
 					return err
 				}
@@ -5,6 +5,7 @@ import (
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
 func Test_OktaAppOauthOmitSecret_True(t *testing.T) {
@@ -51,7 +52,7 @@ resource "okta_app_oauth" "example" {
 			Expected: helper.Issues{
 				{
 					Rule:    NewOktaAppOauthOmitSecretRule(),
-					Message: "OAuth application secret should be omitted",
+					Message: "OAuth application secret should be omitted, otherwise it is stored in Terraform state",
 					Range: hcl.Range{
 						Filename: "resource.tf",
 						Start:    hcl.Pos{Line: 3, Column: 3},
@@ -89,7 +90,7 @@ resource "okta_app_oauth" "example" {
 			Expected: helper.Issues{
 				{
 					Rule:    NewOktaAppOauthOmitSecretRule(),
-					Message: "OAuth application secret should be omitted",
+					Message: "OAuth application secret should be omitted, otherwise it is stored in Terraform state",
 					Range: hcl.Range{
 						Filename: "resource.tf",
 						Start:    hcl.Pos{Line: 2, Column: 1},
@@ -112,3 +113,66 @@ resource "okta_app_oauth" "example" {
 		helper.AssertIssues(t, tc.Expected, runner.Issues)
 	}
 }
+
+func Test_OktaAppOauthOmitSecret_Severity(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected tflint.Severity
+	}{
+		{
+			Name: "Default severity",
+			Content: `
+resource "okta_app_oauth" "example" {
+}`,
+			Expected: tflint.WARNING,
+		},
+		{
+			Name: "Configured severity",
+			Content: `
+resource "okta_app_oauth" "example" {
+}`,
+			Config: `
+rule "okta_app_oauth_omit_secret" {
+  enabled  = true
+  severity = "error"
+}`,
+			Expected: tflint.ERROR,
+		},
+	}
+
+	rule := NewOktaAppOauthOmitSecretRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		if len(runner.Issues) != 1 {
+			t.Fatalf("Expected one issue, got %d", len(runner.Issues))
+		}
+		if severity := runner.Issues[0].Rule.Severity(); severity != tc.Expected {
+			t.Errorf("%s: expected severity %s, got %s", tc.Name, tc.Expected, severity)
+		}
+	}
+}
+
+func Test_OktaAppOauthOmitSecret_InvalidSeverity(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"resource.tf": `
+resource "okta_app_oauth" "example" {
+}`,
+		".tflint.hcl": `
+rule "okta_app_oauth_omit_secret" {
+  enabled  = true
+  severity = "critical"
+}`,
+	})
+
+	if err := NewOktaAppOauthOmitSecretRule().Check(runner); err == nil {
+		t.Fatal("Expected an error for an invalid severity")
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthPkceRequiredRule struct {
+	tflint.DefaultRule
+	resourceType     string
+	attributeName    string
+	appTypes         []string
+	authMethodName   string
+	publicAppTypes   []string
+	publicAuthMethod string
+}
+
+func NewOktaAppOauthPkceRequiredRule() *OktaAppOauthPkceRequiredRule {
+	return &OktaAppOauthPkceRequiredRule{
+		resourceType:     "okta_app_oauth",
+		attributeName:    "pkce_required",
+		appTypes:         []string{"browser", "native"},
+		authMethodName:   "token_endpoint_auth_method",
+		publicAppTypes:   []string{"browser"},
+		publicAuthMethod: "none",
+	}
+}
+
+func (r *OktaAppOauthPkceRequiredRule) Name() string {
+	return "okta_app_oauth_pkce_required"
+}
+
+func (r *OktaAppOauthPkceRequiredRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthPkceRequiredRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthPkceRequiredRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: r.attributeName}, {Name: r.authMethodName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		typeAttribute, exists := resource.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+
+		appType := ""
+		err := runner.EvaluateExpr(typeAttribute.Expr, func(value string) error {
+			appType = value
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(r.appTypes, appType) {
+			continue
+		}
+
+		issueMessage := fmt.Sprintf("OAuth application of type %s must set %s = true", appType, r.attributeName)
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			err = runner.EmitIssue(r, issueMessage, resource.DefRange)
+			if err != nil {
+				return err
+			}
+		} else {
+			err := runner.EvaluateExpr(attribute.Expr, func(pkceRequired bool) error {
+				if !pkceRequired {
+					return runner.EmitIssue(r, issueMessage, attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		authMethod, exists := resource.Body.Attributes[r.authMethodName]
+		if exists && slices.Contains(r.publicAppTypes, appType) {
+			err := runner.EvaluateExpr(authMethod.Expr, func(method string) error {
+				if method != r.publicAuthMethod {
+					return runner.EmitIssue(r, fmt.Sprintf("OAuth application of type %s must set %s = %q and rely on PKCE", appType, r.authMethodName, r.publicAuthMethod), authMethod.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthPkceRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Browser application requires PKCE",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type                       = "browser"
+  pkce_required              = true
+  token_endpoint_auth_method = "none"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Web application does not require PKCE",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type = "web"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Native application omits PKCE",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type = "native"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthPkceRequiredRule(),
+					Message: "OAuth application of type native must set pkce_required = true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "Browser application disables PKCE and uses a client secret",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type                       = "browser"
+  pkce_required              = false
+  token_endpoint_auth_method = "client_secret_basic"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthPkceRequiredRule(),
+					Message: "OAuth application of type browser must set pkce_required = true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 37},
+					},
+				},
+				{
+					Rule:    NewOktaAppOauthPkceRequiredRule(),
+					Message: `OAuth application of type browser must set token_endpoint_auth_method = "none" and rely on PKCE`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 53},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthPkceRequiredRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
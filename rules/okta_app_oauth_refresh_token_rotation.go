@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthRefreshTokenRotationRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeName  string
+	leewayName     string
+	appTypes       []string
+	rotationPolicy string
+	maxLeeway      int
+}
+
+// MaxLeeway is the most seconds a rotated refresh token may remain valid for.
+type oktaAppOauthRefreshTokenRotationRuleConfig struct {
+	MaxLeeway int `hclext:"max_leeway,optional"`
+}
+
+func NewOktaAppOauthRefreshTokenRotationRule() *OktaAppOauthRefreshTokenRotationRule {
+	return &OktaAppOauthRefreshTokenRotationRule{
+		resourceType:   "okta_app_oauth",
+		attributeName:  "refresh_token_rotation",
+		leewayName:     "refresh_token_leeway",
+		appTypes:       []string{"browser", "native"},
+		rotationPolicy: "ROTATE",
+		maxLeeway:      30,
+	}
+}
+
+func (r *OktaAppOauthRefreshTokenRotationRule) Name() string {
+	return "okta_app_oauth_refresh_token_rotation"
+}
+
+func (r *OktaAppOauthRefreshTokenRotationRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthRefreshTokenRotationRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthRefreshTokenRotationRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppOauthRefreshTokenRotationRuleConfig{MaxLeeway: r.maxLeeway}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "type"}, {Name: r.attributeName}, {Name: r.leewayName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		typeAttribute, exists := resource.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+
+		appType := ""
+		err := runner.EvaluateExpr(typeAttribute.Expr, func(value string) error {
+			appType = value
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(r.appTypes, appType) {
+			continue
+		}
+
+		issueMessage := fmt.Sprintf("OAuth application of type %s must set %s = %q", appType, r.attributeName, r.rotationPolicy)
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			err = runner.EmitIssue(r, issueMessage, resource.DefRange)
+			if err != nil {
+				return err
+			}
+		} else {
+			err := runner.EvaluateExpr(attribute.Expr, func(rotation string) error {
+				if rotation != r.rotationPolicy {
+					return runner.EmitIssue(r, issueMessage, attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if leeway, exists := resource.Body.Attributes[r.leewayName]; exists {
+			err := runner.EvaluateExpr(leeway.Expr, func(seconds int) error {
+				if seconds > config.MaxLeeway {
+					return runner.EmitIssue(r, fmt.Sprintf("%s is %d seconds, which exceeds the maximum of %d", r.leewayName, seconds, config.MaxLeeway), leeway.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthRefreshTokenRotationRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Refresh tokens are rotated",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type                   = "browser"
+  refresh_token_rotation = "ROTATE"
+  refresh_token_leeway   = 30
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Web application does not require rotation",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type = "web"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Rotation is omitted",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type = "native"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthRefreshTokenRotationRule(),
+					Message: `OAuth application of type native must set refresh_token_rotation = "ROTATE"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "Refresh tokens are static with a long leeway",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type                   = "browser"
+  refresh_token_rotation = "STATIC"
+  refresh_token_leeway   = 60
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthRefreshTokenRotationRule(),
+					Message: `OAuth application of type browser must set refresh_token_rotation = "ROTATE"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+				{
+					Rule:    NewOktaAppOauthRefreshTokenRotationRule(),
+					Message: "refresh_token_leeway is 60 seconds, which exceeds the maximum of 30",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured maximum leeway",
+			Content: `
+resource "okta_app_oauth" "example" {
+  type                   = "browser"
+  refresh_token_rotation = "ROTATE"
+  refresh_token_leeway   = 10
+}`,
+			Config: `
+rule "okta_app_oauth_refresh_token_rotation" {
+  enabled    = true
+  max_leeway = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthRefreshTokenRotationRule(),
+					Message: "refresh_token_leeway is 10 seconds, which exceeds the maximum of 0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthRefreshTokenRotationRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
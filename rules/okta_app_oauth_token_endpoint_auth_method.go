@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthTokenEndpointAuthMethodRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	defaultMethod string
+	allowed       []string
+}
+
+// Allowed lists the token endpoint authentication methods applications may use.
+type oktaAppOauthTokenEndpointAuthMethodRuleConfig struct {
+	Allowed []string `hclext:"allowed,optional"`
+}
+
+func NewOktaAppOauthTokenEndpointAuthMethodRule() *OktaAppOauthTokenEndpointAuthMethodRule {
+	return &OktaAppOauthTokenEndpointAuthMethodRule{
+		resourceType:  "okta_app_oauth",
+		attributeName: "token_endpoint_auth_method",
+		defaultMethod: "client_secret_basic",
+		allowed:       []string{"client_secret_post", "client_secret_jwt", "private_key_jwt", "none"},
+	}
+}
+
+func (r *OktaAppOauthTokenEndpointAuthMethodRule) Name() string {
+	return "okta_app_oauth_token_endpoint_auth_method"
+}
+
+func (r *OktaAppOauthTokenEndpointAuthMethodRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaAppOauthTokenEndpointAuthMethodRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthTokenEndpointAuthMethodRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppOauthTokenEndpointAuthMethodRuleConfig{Allowed: r.allowed}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	allowed := strings.Join(config.Allowed, ", ")
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if !slices.Contains(config.Allowed, r.defaultMethod) {
+				err := runner.EmitIssue(r, fmt.Sprintf("%s defaults to %s, which is not one of the allowed methods: %s", r.attributeName, r.defaultMethod, allowed), resource.DefRange)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(method string) error {
+			if !slices.Contains(config.Allowed, method) {
+				return runner.EmitIssue(r, fmt.Sprintf("%s %s is not one of the allowed methods: %s", r.attributeName, method, allowed), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthTokenEndpointAuthMethodRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Method is allowed",
+			Content: `
+resource "okta_app_oauth" "example" {
+  token_endpoint_auth_method = "private_key_jwt"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Method is not allowed",
+			Content: `
+resource "okta_app_oauth" "example" {
+  token_endpoint_auth_method = "client_secret_basic"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthTokenEndpointAuthMethodRule(),
+					Message: "token_endpoint_auth_method client_secret_basic is not one of the allowed methods: client_secret_post, client_secret_jwt, private_key_jwt, none",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 53},
+					},
+				},
+			},
+		},
+		{
+			Name: "Default method is not allowed",
+			Content: `
+resource "okta_app_oauth" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthTokenEndpointAuthMethodRule(),
+					Message: "token_endpoint_auth_method defaults to client_secret_basic, which is not one of the allowed methods: client_secret_post, client_secret_jwt, private_key_jwt, none",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured methods",
+			Content: `
+resource "okta_app_oauth" "example" {
+}
+
+resource "okta_app_oauth" "public" {
+  token_endpoint_auth_method = "none"
+}`,
+			Config: `
+rule "okta_app_oauth_token_endpoint_auth_method" {
+  enabled = true
+  allowed = ["client_secret_basic", "private_key_jwt"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthTokenEndpointAuthMethodRule(),
+					Message: "token_endpoint_auth_method none is not one of the allowed methods: client_secret_basic, private_key_jwt",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 38},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppOauthTokenEndpointAuthMethodRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
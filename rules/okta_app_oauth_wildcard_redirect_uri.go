@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppOauthWildcardRedirectURIRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	attributeName     string
+	wildcardAttribute string
+	wildcardDisabled  string
+	labelAttribute    string
+}
+
+// Exempt lists the labels of applications which may use wildcard redirects. Neither the redirect URIs
+// nor wildcard_redirect of exempt applications are checked.
+type oktaAppOauthWildcardRedirectURIRuleConfig struct {
+	Exempt []string `hclext:"exempt,optional"`
+}
+
+func NewOktaAppOauthWildcardRedirectURIRule() *OktaAppOauthWildcardRedirectURIRule {
+	return &OktaAppOauthWildcardRedirectURIRule{
+		resourceType:      "okta_app_oauth",
+		attributeName:     "redirect_uris",
+		wildcardAttribute: "wildcard_redirect",
+		wildcardDisabled:  "DISABLED",
+		labelAttribute:    "label",
+	}
+}
+
+func (r *OktaAppOauthWildcardRedirectURIRule) Name() string {
+	return "okta_app_oauth_wildcard_redirect_uri"
+}
+
+func (r *OktaAppOauthWildcardRedirectURIRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppOauthWildcardRedirectURIRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppOauthWildcardRedirectURIRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppOauthWildcardRedirectURIRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.wildcardAttribute}, {Name: r.labelAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		exempt := false
+		if labelAttribute, exists := resource.Body.Attributes[r.labelAttribute]; exists && len(config.Exempt) > 0 {
+			err := runner.EvaluateExpr(labelAttribute.Expr, func(label string) error {
+				exempt = slices.Contains(config.Exempt, label)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if exempt {
+			continue
+		}
+
+		if attribute, exists := resource.Body.Attributes[r.attributeName]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(redirectURIs []string) error {
+				for _, redirectURI := range redirectURIs {
+					if strings.Contains(redirectURI, "*") {
+						err = runner.EmitIssue(r, fmt.Sprintf("Redirect URI %s must not contain a wildcard", redirectURI), attribute.Range)
+						if err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if attribute, exists := resource.Body.Attributes[r.wildcardAttribute]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(wildcardRedirect string) error {
+				if wildcardRedirect != r.wildcardDisabled {
+					return runner.EmitIssue(r, fmt.Sprintf("%s should be %q", r.wildcardAttribute, r.wildcardDisabled), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppOauthWildcardRedirectURIRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Redirect URIs are exact",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris     = ["https://example.com/callback"]
+  wildcard_redirect = "DISABLED"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Redirect URI contains a wildcard",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris = ["https://*.example.com/callback"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthWildcardRedirectURIRule(),
+					Message: "Redirect URI https://*.example.com/callback must not contain a wildcard",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 53},
+					},
+				},
+			},
+		},
+		{
+			Name: "Wildcard redirects are enabled",
+			Content: `
+resource "okta_app_oauth" "example" {
+  redirect_uris     = ["https://example.com/callback"]
+  wildcard_redirect = "SUBDOMAIN"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppOauthWildcardRedirectURIRule(),
+					Message: `wildcard_redirect should be "DISABLED"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "Application is exempt",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label             = "Preview Environments"
+  redirect_uris     = ["https://*.preview.example.com/callback"]
+  wildcard_redirect = "SUBDOMAIN"
+}`,
+			Config: `
+rule "okta_app_oauth_wildcard_redirect_uri" {
+  enabled = true
+  exempt  = ["Preview Environments"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppOauthWildcardRedirectURIRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
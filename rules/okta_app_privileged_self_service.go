@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppPrivilegedSelfServiceRule struct {
+	tflint.DefaultRule
+	resourceTypes  []string
+	attributeName  string
+	labelAttribute string
+	privileged     string
+}
+
+// Privileged is a regular expression matching the labels of privileged applications.
+type oktaAppPrivilegedSelfServiceRuleConfig struct {
+	Privileged string `hclext:"privileged,optional"`
+}
+
+func NewOktaAppPrivilegedSelfServiceRule() *OktaAppPrivilegedSelfServiceRule {
+	return &OktaAppPrivilegedSelfServiceRule{
+		resourceTypes:  []string{"okta_app_*"},
+		attributeName:  "accessibility_self_service",
+		labelAttribute: "label",
+		privileged:     "(?i)admin",
+	}
+}
+
+func (r *OktaAppPrivilegedSelfServiceRule) Name() string {
+	return "okta_app_privileged_self_service"
+}
+
+func (r *OktaAppPrivilegedSelfServiceRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppPrivilegedSelfServiceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppPrivilegedSelfServiceRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppPrivilegedSelfServiceRuleConfig{Privileged: r.privileged}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	privileged, err := regexp.Compile(config.Privileged)
+	if err != nil {
+		return fmt.Errorf("invalid privileged pattern for %s rule: %w", r.Name(), err)
+	}
+
+	resources, err := getResourcesContent(runner, r.resourceTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.labelAttribute}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		labelAttribute, labelExists := resource.Body.Attributes[r.labelAttribute]
+		if !exists || !labelExists {
+			continue
+		}
+
+		label := ""
+		err := runner.EvaluateExpr(labelAttribute.Expr, func(value string) error {
+			label = value
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if !privileged.MatchString(label) {
+			continue
+		}
+
+		err = runner.EvaluateExpr(attribute.Expr, func(selfService bool) error {
+			if selfService {
+				return runner.EmitIssue(r, fmt.Sprintf("Privileged application %s should not be requestable through self-service", label), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppPrivilegedSelfServiceRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Unprivileged application allows self-service",
+			Content: `
+resource "okta_app_bookmark" "example" {
+  label                      = "Wiki"
+  accessibility_self_service = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Privileged application does not allow self-service",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label                      = "Billing Admin"
+  accessibility_self_service = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Privileged application allows self-service",
+			Content: `
+resource "okta_app_saml" "example" {
+  label                      = "AWS Admin Console"
+  accessibility_self_service = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppPrivilegedSelfServiceRule(),
+					Message: "Privileged application AWS Admin Console should not be requestable through self-service",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured privileged labels",
+			Content: `
+resource "okta_app_saml" "example" {
+  label                      = "Production Database"
+  accessibility_self_service = true
+}`,
+			Config: `
+rule "okta_app_privileged_self_service" {
+  enabled    = true
+  privileged = "(?i)^production "
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppPrivilegedSelfServiceRule(),
+					Message: "Privileged application Production Database should not be requestable through self-service",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppPrivilegedSelfServiceRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppProfileJSONRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+	attributeName string
+}
+
+func NewOktaAppProfileJSONRule() *OktaAppProfileJSONRule {
+	return &OktaAppProfileJSONRule{
+		resourceTypes: []string{"okta_app_oauth", "okta_app_saml"},
+		attributeName: "profile",
+	}
+}
+
+func (r *OktaAppProfileJSONRule) Name() string {
+	return "okta_app_profile_json"
+}
+
+func (r *OktaAppProfileJSONRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppProfileJSONRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppProfileJSONRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes[r.attributeName]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(document string) error {
+				var value any
+				if err := json.Unmarshal([]byte(document), &value); err != nil {
+					return runner.EmitIssue(r, fmt.Sprintf("Application profile is not valid JSON: %s", err), attribute.Range)
+				}
+				if _, ok := value.(map[string]any); !ok {
+					return runner.EmitIssue(r, "Application profile must be a JSON object", attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppProfileJSONRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Profile is a JSON object",
+			Content: `
+resource "okta_app_oauth" "example" {
+  profile = <<-EOT
+    {"owner": "security"}
+  EOT
+}
+
+resource "okta_app_saml" "example" {
+  profile = "{\"owner\": \"security\"}"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Profile is not valid JSON",
+			Content: `
+resource "okta_app_oauth" "example" {
+  profile = "{\"owner\": }"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppProfileJSONRule(),
+					Message: "Application profile is not valid JSON: invalid character '}' looking for beginning of value",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Profile is not an object",
+			Content: `
+resource "okta_app_saml" "example" {
+  profile = "[\"security\"]"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppProfileJSONRule(),
+					Message: "Application profile must be a JSON object",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppProfileJSONRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
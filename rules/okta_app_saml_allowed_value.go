@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaAppSamlAllowedValueRule checks that an attribute of a SAML application, such as its
+// cryptographic algorithms, is set to one of an allowlist of values.
+type OktaAppSamlAllowedValueRule struct {
+	tflint.DefaultRule
+	name          string
+	resourceType  string
+	attributeName string
+	allowed       []string
+}
+
+// Allowed lists the values applications may use.
+type oktaAppSamlAllowedValueRuleConfig struct {
+	Allowed []string `hclext:"allowed,optional"`
+}
+
+func NewOktaAppSamlDigestAlgorithmRule() *OktaAppSamlAllowedValueRule {
+	return &OktaAppSamlAllowedValueRule{
+		name:          "okta_app_saml_digest_algorithm",
+		resourceType:  "okta_app_saml",
+		attributeName: "digest_algorithm",
+		allowed:       []string{"SHA256"},
+	}
+}
+
+func NewOktaAppSamlSignatureAlgorithmRule() *OktaAppSamlAllowedValueRule {
+	return &OktaAppSamlAllowedValueRule{
+		name:          "okta_app_saml_signature_algorithm",
+		resourceType:  "okta_app_saml",
+		attributeName: "signature_algorithm",
+		allowed:       []string{"RSA_SHA256"},
+	}
+}
+
+func NewOktaAppSamlSubjectNameIDFormatRule() *OktaAppSamlAllowedValueRule {
+	return &OktaAppSamlAllowedValueRule{
+		name:          "okta_app_saml_subject_name_id_format",
+		resourceType:  "okta_app_saml",
+		attributeName: "subject_name_id_format",
+		allowed: []string{
+			"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+			"urn:oasis:names:tc:SAML:1.1:nameid-format:x509SubjectName",
+			"urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+			"urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
+		},
+	}
+}
+
+func (r *OktaAppSamlAllowedValueRule) Name() string {
+	return r.name
+}
+
+func (r *OktaAppSamlAllowedValueRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSamlAllowedValueRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSamlAllowedValueRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSamlAllowedValueRuleConfig{Allowed: r.allowed}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(value string) error {
+			if !slices.Contains(config.Allowed, value) {
+				return runner.EmitIssue(r, fmt.Sprintf("%s %s is not allowed, use one of: %s", r.attributeName, value, strings.Join(config.Allowed, ", ")), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSamlDigestAlgorithmRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Digest algorithm is SHA256",
+			Content: `
+resource "okta_app_saml" "example" {
+  digest_algorithm = "SHA256"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Digest algorithm is SHA1",
+			Content: `
+resource "okta_app_saml" "example" {
+  digest_algorithm = "SHA1"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlDigestAlgorithmRule(),
+					Message: "digest_algorithm SHA1 is not allowed, use one of: SHA256",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured digest algorithms",
+			Content: `
+resource "okta_app_saml" "example" {
+  digest_algorithm = "SHA1"
+}`,
+			Config: `
+rule "okta_app_saml_digest_algorithm" {
+  enabled = true
+  allowed = ["SHA1", "SHA256"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppSamlDigestAlgorithmRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaAppSamlSignatureAlgorithmRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Signature algorithm is RSA_SHA256",
+			Content: `
+resource "okta_app_saml" "example" {
+  signature_algorithm = "RSA_SHA256"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Signature algorithm is RSA_SHA1",
+			Content: `
+resource "okta_app_saml" "example" {
+  signature_algorithm = "RSA_SHA1"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlSignatureAlgorithmRule(),
+					Message: "signature_algorithm RSA_SHA1 is not allowed, use one of: RSA_SHA256",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured signature algorithms",
+			Content: `
+resource "okta_app_saml" "example" {
+  signature_algorithm = "RSA_SHA256"
+}`,
+			Config: `
+rule "okta_app_saml_signature_algorithm" {
+  enabled = true
+  allowed = ["RSA_SHA512"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlSignatureAlgorithmRule(),
+					Message: "signature_algorithm RSA_SHA256 is not allowed, use one of: RSA_SHA512",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSamlSignatureAlgorithmRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaAppSamlSubjectNameIDFormatRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Format is emailAddress",
+			Content: `
+resource "okta_app_saml" "example" {
+  subject_name_id_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Format is unspecified",
+			Content: `
+resource "okta_app_saml" "example" {
+  subject_name_id_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlSubjectNameIDFormatRule(),
+					Message: "subject_name_id_format urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified is not allowed, use one of: urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress, urn:oasis:names:tc:SAML:1.1:nameid-format:x509SubjectName, urn:oasis:names:tc:SAML:2.0:nameid-format:persistent, urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 83},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured formats",
+			Content: `
+resource "okta_app_saml" "example" {
+  subject_name_id_format = "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"
+}`,
+			Config: `
+rule "okta_app_saml_subject_name_id_format" {
+  enabled = true
+  allowed = ["urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlSubjectNameIDFormatRule(),
+					Message: "subject_name_id_format urn:oasis:names:tc:SAML:2.0:nameid-format:transient is not allowed, use one of: urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 81},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSamlSubjectNameIDFormatRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSamlAssertionSignedRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	attributeName     string
+	responseAttribute string
+}
+
+// RequireSignature warns about applications which sign neither the assertion nor the response.
+type oktaAppSamlAssertionSignedRuleConfig struct {
+	RequireSignature bool `hclext:"require_signature,optional"`
+}
+
+func NewOktaAppSamlAssertionSignedRule() *OktaAppSamlAssertionSignedRule {
+	return &OktaAppSamlAssertionSignedRule{
+		resourceType:      "okta_app_saml",
+		attributeName:     "assertion_signed",
+		responseAttribute: "response_signed",
+	}
+}
+
+func (r *OktaAppSamlAssertionSignedRule) Name() string {
+	return "okta_app_saml_assertion_signed"
+}
+
+func (r *OktaAppSamlAssertionSignedRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSamlAssertionSignedRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSamlAssertionSignedRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSamlAssertionSignedRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.responseAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		signed := false
+
+		if attribute, exists := resource.Body.Attributes[r.attributeName]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(assertionSigned bool) error {
+				signed = assertionSigned
+				if !assertionSigned {
+					return runner.EmitIssue(r, "SAML assertions must be signed", attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if attribute, exists := resource.Body.Attributes[r.responseAttribute]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(responseSigned bool) error {
+				signed = signed || responseSigned
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !signed && config.RequireSignature {
+			warning := &ruleWithSeverity{Rule: r, severity: tflint.WARNING}
+			err := runner.EmitIssue(warning, fmt.Sprintf("SAML application should set %s or %s to true", r.attributeName, r.responseAttribute), resource.DefRange)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaAppSamlAssertionSignedRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Assertion is signed",
+			Content: `
+resource "okta_app_saml" "example" {
+  assertion_signed = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Signing is not configured",
+			Content: `
+resource "okta_app_saml" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Assertion is not signed",
+			Content: `
+resource "okta_app_saml" "example" {
+  assertion_signed = false
+  response_signed  = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlAssertionSignedRule(),
+					Message: "SAML assertions must be signed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "Response is signed",
+			Content: `
+resource "okta_app_saml" "example" {
+  response_signed = true
+}`,
+			Config: `
+rule "okta_app_saml_assertion_signed" {
+  enabled           = true
+  require_signature = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Nothing is signed",
+			Content: `
+resource "okta_app_saml" "example" {
+  response_signed = false
+}`,
+			Config: `
+rule "okta_app_saml_assertion_signed" {
+  enabled           = true
+  require_signature = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAppSamlAssertionSignedRule(), severity: tflint.WARNING},
+					Message: "SAML application should set assertion_signed or response_signed to true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 35},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSamlAssertionSignedRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSamlHonorForceAuthnRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeName  string
+	labelAttribute string
+}
+
+// Exclude is a regular expression matching the labels of applications whose service providers cannot handle ForceAuthn.
+type oktaAppSamlHonorForceAuthnRuleConfig struct {
+	Exclude  string `hclext:"exclude,optional"`
+	Severity string `hclext:"severity,optional"`
+}
+
+func NewOktaAppSamlHonorForceAuthnRule() *OktaAppSamlHonorForceAuthnRule {
+	return &OktaAppSamlHonorForceAuthnRule{
+		resourceType:   "okta_app_saml",
+		attributeName:  "honor_force_authn",
+		labelAttribute: "label",
+	}
+}
+
+func (r *OktaAppSamlHonorForceAuthnRule) Name() string {
+	return "okta_app_saml_honor_force_authn"
+}
+
+func (r *OktaAppSamlHonorForceAuthnRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSamlHonorForceAuthnRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppSamlHonorForceAuthnRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSamlHonorForceAuthnRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	rule, err := withSeverity(r, config.Severity)
+	if err != nil {
+		return err
+	}
+
+	var exclude *regexp.Regexp
+	if config.Exclude != "" {
+		exclude, err = regexp.Compile(config.Exclude)
+		if err != nil {
+			return fmt.Errorf("invalid exclude pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.labelAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		excluded := false
+		if labelAttribute, exists := resource.Body.Attributes[r.labelAttribute]; exists && exclude != nil {
+			err := runner.EvaluateExpr(labelAttribute.Expr, func(label string) error {
+				excluded = exclude.MatchString(label)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if excluded {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(honorForceAuthn bool) error {
+			if !honorForceAuthn {
+				return runner.EmitIssue(rule, "SAML application should honor ForceAuthn requests from the service provider", attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
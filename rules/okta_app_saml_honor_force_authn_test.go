@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaAppSamlHonorForceAuthnRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "ForceAuthn is honored",
+			Content: `
+resource "okta_app_saml" "example" {
+  label             = "Example"
+  honor_force_authn = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ForceAuthn is not honored",
+			Content: `
+resource "okta_app_saml" "example" {
+  label             = "Example"
+  honor_force_authn = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlHonorForceAuthnRule(),
+					Message: "SAML application should honor ForceAuthn requests from the service provider",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Legacy application is excluded",
+			Content: `
+resource "okta_app_saml" "example" {
+  label             = "Legacy Example"
+  honor_force_authn = false
+}`,
+			Config: `
+rule "okta_app_saml_honor_force_authn" {
+  enabled = true
+  exclude = "^Legacy "
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Configured severity",
+			Content: `
+resource "okta_app_saml" "example" {
+  label             = "Example"
+  honor_force_authn = false
+}`,
+			Config: `
+rule "okta_app_saml_honor_force_authn" {
+  enabled  = true
+  severity = "error"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAppSamlHonorForceAuthnRule(), severity: tflint.ERROR},
+					Message: "SAML application should honor ForceAuthn requests from the service provider",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSamlHonorForceAuthnRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
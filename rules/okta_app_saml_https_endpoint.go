@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSamlHTTPSEndpointRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeNames []string
+}
+
+func NewOktaAppSamlHTTPSEndpointRule() *OktaAppSamlHTTPSEndpointRule {
+	return &OktaAppSamlHTTPSEndpointRule{
+		resourceType:   "okta_app_saml",
+		attributeNames: []string{"sso_url", "recipient", "destination", "audience"},
+	}
+}
+
+func (r *OktaAppSamlHTTPSEndpointRule) Name() string {
+	return "okta_app_saml_https_endpoint"
+}
+
+func (r *OktaAppSamlHTTPSEndpointRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSamlHTTPSEndpointRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSamlHTTPSEndpointRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	attributes := []hclext.AttributeSchema{}
+	for _, attributeName := range r.attributeNames {
+		attributes = append(attributes, hclext.AttributeSchema{Name: attributeName})
+	}
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, attributeName := range r.attributeNames {
+			attribute, exists := resource.Body.Attributes[attributeName]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(endpoint string) error {
+				insecure, err := isInsecureEndpoint(endpoint)
+				if err != nil {
+					return err
+				}
+				if insecure {
+					return runner.EmitIssue(r, fmt.Sprintf("%s %s must use HTTPS", attributeName, endpoint), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isInsecureEndpoint reports whether the value is a URL with a host which does not use HTTPS.
+// Values which are not URLs, such as URN audiences, are not endpoints and are ignored.
+func isInsecureEndpoint(endpoint string) (bool, error) {
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return false, err
+	}
+	if uri.Host == "" {
+		return false, nil
+	}
+
+	return isInsecureURI(endpoint, nil)
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSamlHTTPSEndpointRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Endpoints use HTTPS",
+			Content: `
+resource "okta_app_saml" "example" {
+  sso_url     = "https://example.com/saml/acs"
+  recipient   = "https://example.com/saml/acs"
+  destination = "https://example.com/saml/acs"
+  audience    = "https://example.com/saml/metadata"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Audience is not a URL",
+			Content: `
+resource "okta_app_saml" "example" {
+  audience = "urn:amazon:webservices"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Endpoints use HTTP",
+			Content: `
+resource "okta_app_saml" "example" {
+  sso_url     = "http://example.com/saml/acs"
+  recipient   = "https://example.com/saml/acs"
+  destination = "http://example.com/saml/acs"
+  audience    = "http://example.com/saml/metadata"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlHTTPSEndpointRule(),
+					Message: "sso_url http://example.com/saml/acs must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+				{
+					Rule:    NewOktaAppSamlHTTPSEndpointRule(),
+					Message: "destination http://example.com/saml/acs must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 46},
+					},
+				},
+				{
+					Rule:    NewOktaAppSamlHTTPSEndpointRule(),
+					Message: "audience http://example.com/saml/metadata must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 51},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSamlHTTPSEndpointRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSamlKeyYearsValidRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	maxYears      int
+}
+
+type oktaAppSamlKeyYearsValidRuleConfig struct {
+	MaxYears int `hclext:"max_years,optional"`
+}
+
+func NewOktaAppSamlKeyYearsValidRule() *OktaAppSamlKeyYearsValidRule {
+	return &OktaAppSamlKeyYearsValidRule{
+		resourceType:  "okta_app_saml",
+		attributeName: "key_years_valid",
+		maxYears:      2,
+	}
+}
+
+func (r *OktaAppSamlKeyYearsValidRule) Name() string {
+	return "okta_app_saml_key_years_valid"
+}
+
+func (r *OktaAppSamlKeyYearsValidRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSamlKeyYearsValidRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppSamlKeyYearsValidRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSamlKeyYearsValidRuleConfig{MaxYears: r.maxYears}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(years int) error {
+			if years > config.MaxYears {
+				return runner.EmitIssue(r, fmt.Sprintf("SAML signing certificate is valid for %d years, which exceeds the maximum of %d", years, config.MaxYears), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
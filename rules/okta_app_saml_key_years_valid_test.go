@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSamlKeyYearsValidRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Certificate is valid for two years",
+			Content: `
+resource "okta_app_saml" "example" {
+  key_years_valid = 2
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Certificate is valid for ten years",
+			Content: `
+resource "okta_app_saml" "example" {
+  key_years_valid = 10
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSamlKeyYearsValidRule(),
+					Message: "SAML signing certificate is valid for 10 years, which exceeds the maximum of 2",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured maximum",
+			Content: `
+resource "okta_app_saml" "example" {
+  key_years_valid = 3
+}`,
+			Config: `
+rule "okta_app_saml_key_years_valid" {
+  enabled   = true
+  max_years = 5
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppSamlKeyYearsValidRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
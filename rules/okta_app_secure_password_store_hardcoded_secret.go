@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSecurePasswordStoreHardcodedSecretRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeNames []string
+}
+
+// Attributes lists the attributes which hold credentials, such as optional fields used for PINs.
+type oktaAppSecurePasswordStoreHardcodedSecretRuleConfig struct {
+	Attributes []string `hclext:"attributes,optional"`
+}
+
+func NewOktaAppSecurePasswordStoreHardcodedSecretRule() *OktaAppSecurePasswordStoreHardcodedSecretRule {
+	return &OktaAppSecurePasswordStoreHardcodedSecretRule{
+		resourceType:   "okta_app_secure_password_store",
+		attributeNames: []string{"shared_password"},
+	}
+}
+
+func (r *OktaAppSecurePasswordStoreHardcodedSecretRule) Name() string {
+	return "okta_app_secure_password_store_hardcoded_secret"
+}
+
+func (r *OktaAppSecurePasswordStoreHardcodedSecretRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSecurePasswordStoreHardcodedSecretRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSecurePasswordStoreHardcodedSecretRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSecurePasswordStoreHardcodedSecretRuleConfig{Attributes: r.attributeNames}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	attributes := []hclext.AttributeSchema{}
+	for _, attributeName := range config.Attributes {
+		attributes = append(attributes, hclext.AttributeSchema{Name: attributeName})
+	}
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, attributeName := range config.Attributes {
+			attribute, exists := resource.Body.Attributes[attributeName]
+			if !exists {
+				continue
+			}
+
+			if err := checkHardcodedSecret(runner, r, attribute); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
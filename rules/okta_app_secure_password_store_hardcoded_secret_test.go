@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSecurePasswordStoreHardcodedSecretRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Password comes from a variable",
+			Content: `
+variable "shared_password" {
+  type      = string
+  sensitive = true
+  default   = "secret"
+}
+
+resource "okta_app_secure_password_store" "example" {
+  shared_username = "admin"
+  shared_password = var.shared_password
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Password is hardcoded",
+			Content: `
+resource "okta_app_secure_password_store" "example" {
+  shared_username       = "admin"
+  shared_password       = "secret"
+  optional_field1_value = "1234"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSecurePasswordStoreHardcodedSecretRule(),
+					Message: "shared_password must not be hardcoded, use a sensitive variable instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured credential fields",
+			Content: `
+resource "okta_app_secure_password_store" "example" {
+  shared_password       = ""
+  optional_field1_value = "1234"
+}`,
+			Config: `
+rule "okta_app_secure_password_store_hardcoded_secret" {
+  enabled    = true
+  attributes = ["shared_password", "optional_field1_value"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSecurePasswordStoreHardcodedSecretRule(),
+					Message: "optional_field1_value must not be hardcoded, use a sensitive variable instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSecurePasswordStoreHardcodedSecretRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
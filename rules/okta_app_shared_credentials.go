@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSharedCredentialsRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+func NewOktaAppSharedCredentialsRule() *OktaAppSharedCredentialsRule {
+	return &OktaAppSharedCredentialsRule{
+		resourceType:  "okta_app_shared_credentials",
+		attributeName: "shared_password",
+	}
+}
+
+func (r *OktaAppSharedCredentialsRule) Name() string {
+	return "okta_app_shared_credentials"
+}
+
+func (r *OktaAppSharedCredentialsRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSharedCredentialsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSharedCredentialsRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	warning := &ruleWithSeverity{Rule: r, severity: tflint.WARNING}
+
+	for _, resource := range resources.Blocks {
+		err = runner.EmitIssue(warning, "Shared credentials applications share one account between users, prefer an application with individual accounts", resource.DefRange)
+		if err != nil {
+			return err
+		}
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		if err := checkHardcodedSecret(runner, r, attribute); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaAppSharedCredentialsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Password comes from a variable",
+			Content: `
+variable "shared_password" {
+  type      = string
+  sensitive = true
+  default   = "secret"
+}
+
+resource "okta_app_shared_credentials" "example" {
+  shared_password = var.shared_password
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAppSharedCredentialsRule(), severity: tflint.WARNING},
+					Message: "Shared credentials applications share one account between users, prefer an application with individual accounts",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "Password is hardcoded",
+			Content: `
+resource "okta_app_shared_credentials" "example" {
+  shared_username = "admin"
+  shared_password = "secret"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAppSharedCredentialsRule(), severity: tflint.WARNING},
+					Message: "Shared credentials applications share one account between users, prefer an application with individual accounts",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+				{
+					Rule:    NewOktaAppSharedCredentialsRule(),
+					Message: "shared_password must not be hardcoded, use a sensitive variable instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSharedCredentialsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSignonPolicyRuleCatchAllRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	policyAttribute   string
+	priorityAttribute string
+	accessAttribute   string
+}
+
+func NewOktaAppSignonPolicyRuleCatchAllRule() *OktaAppSignonPolicyRuleCatchAllRule {
+	return &OktaAppSignonPolicyRuleCatchAllRule{
+		resourceType:      "okta_app_signon_policy_rule",
+		policyAttribute:   "policy_id",
+		priorityAttribute: "priority",
+		accessAttribute:   "access",
+	}
+}
+
+func (r *OktaAppSignonPolicyRuleCatchAllRule) Name() string {
+	return "okta_app_signon_policy_rule_catch_all"
+}
+
+func (r *OktaAppSignonPolicyRuleCatchAllRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSignonPolicyRuleCatchAllRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// catchAllCandidate is the lowest priority rule of a policy found so far.
+type catchAllCandidate struct {
+	resource *hclext.Block
+	priority int
+}
+
+func (r *OktaAppSignonPolicyRuleCatchAllRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: r.priorityAttribute}, {Name: r.accessAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// Policies with any rule of unknown priority are skipped, since their order cannot be determined.
+	var policies []string
+	last := map[string]*catchAllCandidate{}
+	unknown := map[string]bool{}
+
+	for _, resource := range resources.Blocks {
+		policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]
+		if !exists {
+			continue
+		}
+		policy, err := resourceKey(runner, policyAttribute)
+		if err != nil {
+			return err
+		}
+		if policy == "" {
+			continue
+		}
+		if _, exists := last[policy]; !exists && !unknown[policy] {
+			policies = append(policies, policy)
+		}
+
+		known := false
+		if priorityAttribute, exists := resource.Body.Attributes[r.priorityAttribute]; exists {
+			err := runner.EvaluateExpr(priorityAttribute.Expr, func(priority int) error {
+				known = true
+				if last[policy] == nil || priority > last[policy].priority {
+					last[policy] = &catchAllCandidate{resource: resource, priority: priority}
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		unknown[policy] = unknown[policy] || !known
+	}
+
+	for _, policy := range policies {
+		if unknown[policy] || last[policy] == nil {
+			continue
+		}
+
+		resource := last[policy].resource
+		access := "ALLOW"
+		if accessAttribute, exists := resource.Body.Attributes[r.accessAttribute]; exists {
+			access = ""
+			err := runner.EvaluateExpr(accessAttribute.Expr, func(value string) error {
+				access = value
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if access != "ALLOW" {
+			continue
+		}
+
+		err := runner.EmitIssue(r, fmt.Sprintf("Lowest priority rule of application sign-on policy %s allows access, add a catch-all rule with access DENY", policy), resource.DefRange)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSignonPolicyRuleCatchAllRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Catch-all deny rule",
+			Content: `
+resource "okta_app_signon_policy_rule" "deny" {
+  policy_id = okta_app_signon_policy.example.id
+  priority  = 99
+  access    = "DENY"
+}
+
+resource "okta_app_signon_policy_rule" "employees" {
+  policy_id = okta_app_signon_policy.example.id
+  priority  = 1
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Lowest priority rule allows access",
+			Content: `
+resource "okta_app_signon_policy_rule" "deny" {
+  policy_id = okta_app_signon_policy.example.id
+  priority  = 1
+  access    = "DENY"
+}
+
+resource "okta_app_signon_policy_rule" "employees" {
+  policy_id = okta_app_signon_policy.example.id
+  priority  = 2
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRuleCatchAllRule(),
+					Message: "Lowest priority rule of application sign-on policy okta_app_signon_policy.example allows access, add a catch-all rule with access DENY",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 1},
+						End:      hcl.Pos{Line: 8, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "Unknown priority",
+			Content: `
+resource "okta_app_signon_policy_rule" "deny" {
+  policy_id = okta_app_signon_policy.example.id
+  access    = "DENY"
+}
+
+resource "okta_app_signon_policy_rule" "employees" {
+  policy_id = okta_app_signon_policy.example.id
+  priority  = 2
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppSignonPolicyRuleCatchAllRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
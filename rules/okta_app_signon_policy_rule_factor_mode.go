@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSignonPolicyRuleFactorModeRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	nameAttribute string
+}
+
+// Exempt lists the names of rules which may allow single-factor access.
+type oktaAppSignonPolicyRuleFactorModeRuleConfig struct {
+	Exempt []string `hclext:"exempt,optional"`
+}
+
+func NewOktaAppSignonPolicyRuleFactorModeRule() *OktaAppSignonPolicyRuleFactorModeRule {
+	return &OktaAppSignonPolicyRuleFactorModeRule{
+		resourceType:  "okta_app_signon_policy_rule",
+		attributeName: "factor_mode",
+		nameAttribute: "name",
+	}
+}
+
+func (r *OktaAppSignonPolicyRuleFactorModeRule) Name() string {
+	return "okta_app_signon_policy_rule_factor_mode"
+}
+
+func (r *OktaAppSignonPolicyRuleFactorModeRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSignonPolicyRuleFactorModeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSignonPolicyRuleFactorModeRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSignonPolicyRuleFactorModeRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.nameAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		exempt := false
+		if nameAttribute, exists := resource.Body.Attributes[r.nameAttribute]; exists && len(config.Exempt) > 0 {
+			err := runner.EvaluateExpr(nameAttribute.Expr, func(name string) error {
+				exempt = slices.Contains(config.Exempt, name)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if exempt {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(factorMode string) error {
+			if factorMode == "1FA" {
+				return runner.EmitIssue(r, "Application sign-on policy rule allows single-factor access, set factor_mode to 2FA", attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSignonPolicyRuleFactorModeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Two factors",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  factor_mode = "2FA"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Factor mode omitted",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Single factor",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  factor_mode = "1FA"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRuleFactorModeRule(),
+					Message: "Application sign-on policy rule allows single-factor access, set factor_mode to 2FA",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "Exempt rule",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  name        = "Kiosk"
+  factor_mode = "1FA"
+}`,
+			Config: `
+rule "okta_app_signon_policy_rule_factor_mode" {
+  enabled = true
+  exempt  = ["Kiosk"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppSignonPolicyRuleFactorModeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
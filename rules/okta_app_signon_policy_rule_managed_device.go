@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaAppSignonPolicyRuleManagedDeviceRule checks that the rules of sensitive application sign-on policies
+// only allow access from registered, managed devices.
+type OktaAppSignonPolicyRuleManagedDeviceRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	policyType      string
+	policyAttribute string
+	accessAttribute string
+	attributeNames  []string
+	sensitive       string
+}
+
+// Sensitive is a regular expression matching the names of sensitive sign-on policies.
+type oktaAppSignonPolicyRuleManagedDeviceRuleConfig struct {
+	Sensitive string `hclext:"sensitive,optional"`
+}
+
+func NewOktaAppSignonPolicyRuleManagedDeviceRule() *OktaAppSignonPolicyRuleManagedDeviceRule {
+	return &OktaAppSignonPolicyRuleManagedDeviceRule{
+		resourceType:    "okta_app_signon_policy_rule",
+		policyType:      "okta_app_signon_policy",
+		policyAttribute: "policy_id",
+		accessAttribute: "access",
+		attributeNames:  []string{"device_is_registered", "device_is_managed"},
+		sensitive:       "(?i)sensitive",
+	}
+}
+
+func (r *OktaAppSignonPolicyRuleManagedDeviceRule) Name() string {
+	return "okta_app_signon_policy_rule_managed_device"
+}
+
+func (r *OktaAppSignonPolicyRuleManagedDeviceRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSignonPolicyRuleManagedDeviceRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSignonPolicyRuleManagedDeviceRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSignonPolicyRuleManagedDeviceRuleConfig{Sensitive: r.sensitive}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	sensitive, err := regexp.Compile(config.Sensitive)
+	if err != nil {
+		return fmt.Errorf("invalid sensitive pattern for %s rule: %w", r.Name(), err)
+	}
+
+	names, err := resourceNames(runner, r.policyType, "name")
+	if err != nil {
+		return err
+	}
+	policies := map[string]bool{}
+	for address, name := range names {
+		policies[address] = sensitive.MatchString(name)
+	}
+
+	attributes := []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: r.accessAttribute}}
+	for _, name := range r.attributeNames {
+		attributes = append(attributes, hclext.AttributeSchema{Name: name})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]
+		if !exists || !referencesAny(policyAttribute.Expr, policies) {
+			continue
+		}
+
+		denied := false
+		if accessAttribute, exists := resource.Body.Attributes[r.accessAttribute]; exists {
+			err := runner.EvaluateExpr(accessAttribute.Expr, func(access string) error {
+				denied = access == "DENY"
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if denied {
+			continue
+		}
+
+		for _, name := range r.attributeNames {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				err := runner.EmitIssue(r, fmt.Sprintf("%s defaults to false, but must be true for rules of sensitive sign-on policies", name), resource.DefRange)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(required bool) error {
+				if !required {
+					return runner.EmitIssue(r, fmt.Sprintf("%s must be true for rules of sensitive sign-on policies", name), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
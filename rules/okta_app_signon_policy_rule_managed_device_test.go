@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSignonPolicyRuleManagedDeviceRule(t *testing.T) {
+	policies := `
+resource "okta_app_signon_policy" "payroll" {
+  name = "Payroll (sensitive)"
+}
+
+resource "okta_app_signon_policy" "wiki" {
+  name = "Wiki"
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Sensitive policy requires managed devices",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id            = okta_app_signon_policy.payroll.id
+  device_is_registered = true
+  device_is_managed    = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Other policy allows any device",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "wiki" {
+  policy_id = okta_app_signon_policy.wiki.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Sensitive policy allows unmanaged devices",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id            = okta_app_signon_policy.payroll.id
+  device_is_registered = true
+  device_is_managed    = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRuleManagedDeviceRule(),
+					Message: "device_is_managed must be true for rules of sensitive sign-on policies",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 13, Column: 3},
+						End:      hcl.Pos{Line: 13, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "Sensitive policy allows any device by default",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id = okta_app_signon_policy.payroll.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRuleManagedDeviceRule(),
+					Message: "device_is_registered defaults to false, but must be true for rules of sensitive sign-on policies",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 49},
+					},
+				},
+				{
+					Rule:    NewOktaAppSignonPolicyRuleManagedDeviceRule(),
+					Message: "device_is_managed defaults to false, but must be true for rules of sensitive sign-on policies",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "Sensitive policy denies access",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id = okta_app_signon_policy.payroll.id
+  access    = "DENY"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Configured sensitive pattern",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id = okta_app_signon_policy.payroll.id
+}`,
+			Config: `
+rule "okta_app_signon_policy_rule_managed_device" {
+  enabled   = true
+  sensitive = "^Finance"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppSignonPolicyRuleManagedDeviceRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,183 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaAppSignonPolicyRulePhishingResistantRule checks that the rules of sensitive applications' sign-on policies
+// only allow access with a phishing-resistant or hardware-protected factor.
+// A policy is sensitive if its name matches the sensitivity tag, or if it is the authentication policy of an
+// application whose label matches the tag.
+type OktaAppSignonPolicyRulePhishingResistantRule struct {
+	tflint.DefaultRule
+	resourceType         string
+	policyType           string
+	appTypes             []string
+	policyAttribute      string
+	accessAttribute      string
+	constraintsAttribute string
+	sensitive            string
+}
+
+// Sensitive is a regular expression matching the names of sensitive policies or the labels of sensitive applications.
+type oktaAppSignonPolicyRulePhishingResistantRuleConfig struct {
+	Sensitive string `hclext:"sensitive,optional"`
+}
+
+func NewOktaAppSignonPolicyRulePhishingResistantRule() *OktaAppSignonPolicyRulePhishingResistantRule {
+	return &OktaAppSignonPolicyRulePhishingResistantRule{
+		resourceType:         "okta_app_signon_policy_rule",
+		policyType:           "okta_app_signon_policy",
+		appTypes:             []string{"okta_app_*"},
+		policyAttribute:      "policy_id",
+		accessAttribute:      "access",
+		constraintsAttribute: "constraints",
+		sensitive:            "(?i)sensitive",
+	}
+}
+
+func (r *OktaAppSignonPolicyRulePhishingResistantRule) Name() string {
+	return "okta_app_signon_policy_rule_phishing_resistant"
+}
+
+func (r *OktaAppSignonPolicyRulePhishingResistantRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSignonPolicyRulePhishingResistantRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSignonPolicyRulePhishingResistantRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSignonPolicyRulePhishingResistantRuleConfig{Sensitive: r.sensitive}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	sensitive, err := regexp.Compile(config.Sensitive)
+	if err != nil {
+		return fmt.Errorf("invalid sensitive pattern for %s rule: %w", r.Name(), err)
+	}
+
+	policies, err := r.sensitivePolicies(runner, sensitive)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: r.accessAttribute}, {Name: r.constraintsAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]
+		if !exists || !referencesAny(policyAttribute.Expr, policies) {
+			continue
+		}
+
+		denied := false
+		if accessAttribute, exists := resource.Body.Attributes[r.accessAttribute]; exists {
+			err := runner.EvaluateExpr(accessAttribute.Expr, func(access string) error {
+				denied = access == "DENY"
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if denied {
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes[r.constraintsAttribute]
+		if !exists {
+			err := runner.EmitIssue(r, "Rule of a sensitive application's sign-on policy must require a phishing-resistant factor, add a constraint setting possession phishingResistant to REQUIRED", resource.DefRange)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(constraints []string) error {
+			if len(constraints) == 0 {
+				return runner.EmitIssue(r, "Rule of a sensitive application's sign-on policy must require a phishing-resistant factor, add a constraint setting possession phishingResistant to REQUIRED", attribute.Range)
+			}
+			for i, document := range constraints {
+				var constraint struct {
+					Possession map[string]any `json:"possession"`
+				}
+				if err := json.Unmarshal([]byte(document), &constraint); err != nil {
+					if err := runner.EmitIssue(r, fmt.Sprintf("Constraint %d is not valid JSON: %s", i+1, err), attribute.Range); err != nil {
+						return err
+					}
+					continue
+				}
+				if constraint.Possession["phishingResistant"] == "REQUIRED" || constraint.Possession["hardwareProtection"] == "REQUIRED" {
+					continue
+				}
+				if err := runner.EmitIssue(r, fmt.Sprintf("Constraint %d must set possession phishingResistant or hardwareProtection to REQUIRED", i+1), attribute.Range); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sensitivePolicies returns the addresses of sensitive sign-on policies.
+func (r *OktaAppSignonPolicyRulePhishingResistantRule) sensitivePolicies(runner tflint.Runner, sensitive *regexp.Regexp) (map[string]bool, error) {
+	policies := map[string]bool{}
+
+	names, err := resourceNames(runner, r.policyType, "name")
+	if err != nil {
+		return nil, err
+	}
+	for address, name := range names {
+		policies[address] = sensitive.MatchString(name)
+	}
+
+	apps, err := getResourcesContent(runner, r.appTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "label"}, {Name: "authentication_policy"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range apps {
+		label, exists := app.Body.Attributes["label"]
+		policy, referenced := app.Body.Attributes["authentication_policy"]
+		if !exists || !referenced {
+			continue
+		}
+		err := runner.EvaluateExpr(label.Expr, func(label string) error {
+			if !sensitive.MatchString(label) {
+				return nil
+			}
+			for _, traversal := range policy.Expr.Variables() {
+				if address, ok := resourceAddress(traversal); ok {
+					policies[address] = true
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSignonPolicyRulePhishingResistantRule(t *testing.T) {
+	policies := `
+resource "okta_app_signon_policy" "payroll" {
+  name = "Payroll"
+}
+
+resource "okta_app_oauth" "payroll" {
+  label                 = "Payroll (sensitive)"
+  authentication_policy = okta_app_signon_policy.payroll.id
+}
+
+resource "okta_app_signon_policy" "wiki" {
+  name = "Wiki"
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Sensitive application requires a phishing-resistant factor",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id   = okta_app_signon_policy.payroll.id
+  constraints = [
+    "{\"possession\":{\"phishingResistant\":\"REQUIRED\"}}",
+  ]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Sensitive application requires a hardware-protected factor",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id   = okta_app_signon_policy.payroll.id
+  constraints = [
+    "{\"possession\":{\"hardwareProtection\":\"REQUIRED\"}}",
+  ]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Other application without constraints",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "wiki" {
+  policy_id = okta_app_signon_policy.wiki.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Sensitive application denies access",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id = okta_app_signon_policy.payroll.id
+  access    = "DENY"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Sensitive application without constraints",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id = okta_app_signon_policy.payroll.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRulePhishingResistantRule(),
+					Message: "Rule of a sensitive application's sign-on policy must require a phishing-resistant factor, add a constraint setting possession phishingResistant to REQUIRED",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 1},
+						End:      hcl.Pos{Line: 15, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "Sensitive application with weak and invalid constraints",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "payroll" {
+  policy_id   = okta_app_signon_policy.payroll.id
+  constraints = [
+    "{\"possession\":{\"phishingResistant\":\"REQUIRED\"}}",
+    "{\"knowledge\":{\"types\":[\"password\"]}}",
+    "{",
+  ]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRulePhishingResistantRule(),
+					Message: "Constraint 2 must set possession phishingResistant or hardwareProtection to REQUIRED",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 17, Column: 3},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+				{
+					Rule:    NewOktaAppSignonPolicyRulePhishingResistantRule(),
+					Message: "Constraint 3 is not valid JSON: unexpected end of JSON input",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 17, Column: 3},
+						End:      hcl.Pos{Line: 21, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Sensitive policy name",
+			Content: policies + `
+resource "okta_app_signon_policy_rule" "wiki" {
+  policy_id = okta_app_signon_policy.wiki.id
+}`,
+			Config: `
+rule "okta_app_signon_policy_rule_phishing_resistant" {
+  enabled   = true
+  sensitive = "^Wiki$"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRulePhishingResistantRule(),
+					Message: "Rule of a sensitive application's sign-on policy must require a phishing-resistant factor, add a constraint setting possession phishingResistant to REQUIRED",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 1},
+						End:      hcl.Pos{Line: 15, Column: 46},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSignonPolicyRulePhishingResistantRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// iso8601Duration matches the ISO 8601 durations Okta accepts, in weeks, days, hours, minutes and seconds.
+var iso8601Duration = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseISO8601Duration parses an ISO 8601 duration such as PT12H. Years and months are not supported.
+func parseISO8601Duration(value string) (time.Duration, error) {
+	match := iso8601Duration.FindStringSubmatch(value)
+	if match == nil || value == "P" || value[len(value)-1] == 'T' {
+		return 0, fmt.Errorf("%q is not an ISO 8601 duration", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	duration := time.Duration(0)
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+		count, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return 0, fmt.Errorf("%q is not an ISO 8601 duration", value)
+		}
+		duration += time.Duration(count) * unit
+	}
+	return duration, nil
+}
+
+type OktaAppSignonPolicyRuleReauthenticationRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	defaultValue  string
+	max           string
+}
+
+// Max is the longest ISO 8601 duration users may go without re-authenticating.
+type oktaAppSignonPolicyRuleReauthenticationRuleConfig struct {
+	Max string `hclext:"max,optional"`
+}
+
+func NewOktaAppSignonPolicyRuleReauthenticationRule() *OktaAppSignonPolicyRuleReauthenticationRule {
+	return &OktaAppSignonPolicyRuleReauthenticationRule{
+		resourceType:  "okta_app_signon_policy_rule",
+		attributeName: "re_authentication_frequency",
+		defaultValue:  "PT2H",
+		max:           "PT12H",
+	}
+}
+
+func (r *OktaAppSignonPolicyRuleReauthenticationRule) Name() string {
+	return "okta_app_signon_policy_rule_reauthentication"
+}
+
+func (r *OktaAppSignonPolicyRuleReauthenticationRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSignonPolicyRuleReauthenticationRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppSignonPolicyRuleReauthenticationRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppSignonPolicyRuleReauthenticationRuleConfig{Max: r.max}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	maxFrequency, err := parseISO8601Duration(config.Max)
+	if err != nil {
+		return fmt.Errorf("invalid max for %s rule: %w", r.Name(), err)
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if frequency, _ := parseISO8601Duration(r.defaultValue); frequency > maxFrequency {
+				err := runner.EmitIssue(r, fmt.Sprintf("%s defaults to %s, which exceeds the maximum of %s", r.attributeName, r.defaultValue, config.Max), resource.DefRange)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(value string) error {
+			frequency, err := parseISO8601Duration(value)
+			if err != nil {
+				return runner.EmitIssue(r, fmt.Sprintf("%s is invalid: %s", r.attributeName, err), attribute.Range)
+			}
+			if frequency > maxFrequency {
+				return runner.EmitIssue(r, fmt.Sprintf("%s is %s, which exceeds the maximum of %s", r.attributeName, value, config.Max), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
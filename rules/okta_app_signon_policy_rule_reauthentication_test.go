@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSignonPolicyRuleReauthenticationRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Frequency within the maximum",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  re_authentication_frequency = "PT8H30M"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Default frequency",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Frequency exceeds the maximum",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  re_authentication_frequency = "P1D"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRuleReauthenticationRule(),
+					Message: "re_authentication_frequency is P1D, which exceeds the maximum of PT12H",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "Every sign-in",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  re_authentication_frequency = "PT0S"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Invalid frequency",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  re_authentication_frequency = "2 hours"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRuleReauthenticationRule(),
+					Message: "re_authentication_frequency is invalid: \"2 hours\" is not an ISO 8601 duration",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured maximum",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+}`,
+			Config: `
+rule "okta_app_signon_policy_rule_reauthentication" {
+  enabled = true
+  max     = "PT1H"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSignonPolicyRuleReauthenticationRule(),
+					Message: "re_authentication_frequency defaults to PT2H, which exceeds the maximum of PT1H",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSignonPolicyRuleReauthenticationRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppStatusRule struct {
+	tflint.DefaultRule
+	resourceTypes  []string
+	attributeName  string
+	labelAttribute string
+}
+
+// Exclude is a regular expression matching the labels of applications which may be inactive.
+type oktaAppStatusRuleConfig struct {
+	Exclude string `hclext:"exclude,optional"`
+}
+
+func NewOktaAppStatusRule() *OktaAppStatusRule {
+	return &OktaAppStatusRule{
+		resourceTypes: []string{
+			"okta_app_oauth",
+			"okta_app_saml",
+			"okta_app_basic_auth",
+			"okta_app_bookmark",
+			"okta_app_swa",
+			"okta_app_three_field",
+			"okta_app_shared_credentials",
+			"okta_app_secure_password_store",
+			"okta_app_auto_login",
+		},
+		attributeName:  "status",
+		labelAttribute: "label",
+	}
+}
+
+func (r *OktaAppStatusRule) Name() string {
+	return "okta_app_status"
+}
+
+func (r *OktaAppStatusRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppStatusRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppStatusRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppStatusRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var exclude *regexp.Regexp
+	if config.Exclude != "" {
+		var err error
+		exclude, err = regexp.Compile(config.Exclude)
+		if err != nil {
+			return fmt.Errorf("invalid exclude pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	resources, err := getResourcesContent(runner, r.resourceTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.labelAttribute}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		excluded := false
+		if labelAttribute, exists := resource.Body.Attributes[r.labelAttribute]; exists && exclude != nil {
+			err := runner.EvaluateExpr(labelAttribute.Expr, func(label string) error {
+				excluded = exclude.MatchString(label)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if excluded {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(status string) error {
+			if status == "INACTIVE" {
+				return runner.EmitIssue(r, "Inactive application should be removed from the configuration", attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
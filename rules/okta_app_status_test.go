@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppStatusRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Application is active",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label  = "Example"
+  status = "ACTIVE"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Applications are inactive",
+			Content: `
+resource "okta_app_saml" "example" {
+  label  = "Example"
+  status = "INACTIVE"
+}
+
+resource "okta_app_bookmark" "example" {
+  label  = "Example"
+  status = "INACTIVE"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppStatusRule(),
+					Message: "Inactive application should be removed from the configuration",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+				{
+					Rule:    NewOktaAppStatusRule(),
+					Message: "Inactive application should be removed from the configuration",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "Inactive application is excluded",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label  = "Staged Example"
+  status = "INACTIVE"
+}`,
+			Config: `
+rule "okta_app_status" {
+  enabled = true
+  exclude = "^Staged "
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Inactive sign-on policy rule",
+			Content: `
+resource "okta_app_signon_policy_rule" "example" {
+  status = "INACTIVE"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAppStatusRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
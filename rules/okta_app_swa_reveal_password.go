@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppSwaRevealPasswordRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+	attributeName string
+}
+
+func NewOktaAppSwaRevealPasswordRule() *OktaAppSwaRevealPasswordRule {
+	return &OktaAppSwaRevealPasswordRule{
+		resourceTypes: []string{"okta_app_swa", "okta_app_three_field"},
+		attributeName: "reveal_password",
+	}
+}
+
+func (r *OktaAppSwaRevealPasswordRule) Name() string {
+	return "okta_app_swa_reveal_password"
+}
+
+func (r *OktaAppSwaRevealPasswordRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppSwaRevealPasswordRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppSwaRevealPasswordRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes[r.attributeName]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(revealPassword bool) error {
+				if revealPassword {
+					return runner.EmitIssue(r, "Passwords vaulted by the browser plugin should not be revealed to users", attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppSwaRevealPasswordRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Password is not revealed",
+			Content: `
+resource "okta_app_swa" "example" {
+  reveal_password = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Password is revealed",
+			Content: `
+resource "okta_app_swa" "example" {
+  reveal_password = true
+}
+
+resource "okta_app_three_field" "example" {
+  reveal_password = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppSwaRevealPasswordRule(),
+					Message: "Passwords vaulted by the browser plugin should not be revealed to users",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+				{
+					Rule:    NewOktaAppSwaRevealPasswordRule(),
+					Message: "Passwords vaulted by the browser plugin should not be revealed to users",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppSwaRevealPasswordRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
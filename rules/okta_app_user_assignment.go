@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppUserAssignmentRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// Threshold is the number of individual user assignments an application may have before they are reported.
+type oktaAppUserAssignmentRuleConfig struct {
+	Threshold int `hclext:"threshold,optional"`
+}
+
+func NewOktaAppUserAssignmentRule() *OktaAppUserAssignmentRule {
+	return &OktaAppUserAssignmentRule{
+		resourceType:  "okta_app_user",
+		attributeName: "app_id",
+	}
+}
+
+func (r *OktaAppUserAssignmentRule) Name() string {
+	return "okta_app_user_assignment"
+}
+
+func (r *OktaAppUserAssignmentRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppUserAssignmentRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAppUserAssignmentRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAppUserAssignmentRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	var apps []string
+	assignments := map[string][]*hclext.Block{}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		app, err := resourceKey(runner, attribute)
+		if err != nil {
+			return err
+		}
+		if app == "" {
+			continue
+		}
+
+		if _, exists := assignments[app]; !exists {
+			apps = append(apps, app)
+		}
+		assignments[app] = append(assignments[app], resource)
+	}
+
+	for _, app := range apps {
+		if len(assignments[app]) <= config.Threshold {
+			continue
+		}
+		for _, resource := range assignments[app] {
+			err := runner.EmitIssue(r, fmt.Sprintf("Application %s has %d individual user assignments, assign groups with okta_app_group_assignments instead", app, len(assignments[app])), resource.DefRange)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
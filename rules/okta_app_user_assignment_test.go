@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppUserAssignmentRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Application is assigned to groups",
+			Content: `
+resource "okta_app_group_assignments" "example" {
+  app_id = okta_app_oauth.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Application is assigned to a user",
+			Content: `
+resource "okta_app_user" "example" {
+  app_id  = okta_app_oauth.example.id
+  user_id = "00u1abcd"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppUserAssignmentRule(),
+					Message: "Application okta_app_oauth.example has 1 individual user assignments, assign groups with okta_app_group_assignments instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "Assignments are counted per application",
+			Content: `
+resource "okta_app_user" "first" {
+  app_id  = "0oa1abcd"
+  user_id = "00u1abcd"
+}
+
+resource "okta_app_user" "second" {
+  app_id  = "0oa1abcd"
+  user_id = "00u2abcd"
+}
+
+resource "okta_app_user" "other" {
+  app_id  = okta_app_saml.example.id
+  user_id = "00u1abcd"
+}`,
+			Config: `
+rule "okta_app_user_assignment" {
+  enabled   = true
+  threshold = 1
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppUserAssignmentRule(),
+					Message: "Application 0oa1abcd has 2 individual user assignments, assign groups with okta_app_group_assignments instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+				{
+					Rule:    NewOktaAppUserAssignmentRule(),
+					Message: "Application 0oa1abcd has 2 individual user assignments, assign groups with okta_app_group_assignments instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 34},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppUserAssignmentRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
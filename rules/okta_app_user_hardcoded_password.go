@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAppUserHardcodedPasswordRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+func NewOktaAppUserHardcodedPasswordRule() *OktaAppUserHardcodedPasswordRule {
+	return &OktaAppUserHardcodedPasswordRule{
+		resourceType:  "okta_app_user",
+		attributeName: "password",
+	}
+}
+
+func (r *OktaAppUserHardcodedPasswordRule) Name() string {
+	return "okta_app_user_hardcoded_password"
+}
+
+func (r *OktaAppUserHardcodedPasswordRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAppUserHardcodedPasswordRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAppUserHardcodedPasswordRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		if err := checkHardcodedSecret(runner, r, attribute); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
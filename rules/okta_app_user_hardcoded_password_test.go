@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAppUserHardcodedPasswordRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Password comes from a variable",
+			Content: `
+variable "password" {
+  type      = string
+  sensitive = true
+  default   = "secret"
+}
+
+resource "okta_app_user" "example" {
+  username = "user@example.com"
+  password = var.password
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Password is hardcoded",
+			Content: `
+resource "okta_app_user" "example" {
+  username = "user@example.com"
+  password = "secret"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAppUserHardcodedPasswordRule(),
+					Message: "password must not be hardcoded, use a sensitive variable instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAppUserHardcodedPasswordRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
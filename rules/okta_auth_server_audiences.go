@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAuthServerAudiencesRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// AllowedSchemes lists the URI schemes of audiences which are not checked, such as urn to allow URN-style audiences with wildcards.
+type oktaAuthServerAudiencesRuleConfig struct {
+	AllowedSchemes []string `hclext:"allowed_schemes,optional"`
+}
+
+func NewOktaAuthServerAudiencesRule() *OktaAuthServerAudiencesRule {
+	return &OktaAuthServerAudiencesRule{
+		resourceType:  "okta_auth_server",
+		attributeName: "audiences",
+	}
+}
+
+func (r *OktaAuthServerAudiencesRule) Name() string {
+	return "okta_auth_server_audiences"
+}
+
+func (r *OktaAuthServerAudiencesRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerAudiencesRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAuthServerAudiencesRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAuthServerAudiencesRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(audiences []string) error {
+			for _, audience := range audiences {
+				uri, err := url.Parse(audience)
+				if err == nil && slices.Contains(config.AllowedSchemes, uri.Scheme) {
+					continue
+				}
+
+				if strings.Contains(audience, "*") {
+					if err := runner.EmitIssue(r, fmt.Sprintf("Audience %s must not contain a wildcard", audience), attribute.Range); err != nil {
+						return err
+					}
+					continue
+				}
+
+				if err == nil && uri.Scheme == "http" {
+					if err := runner.EmitIssue(r, fmt.Sprintf("Audience %s must use HTTPS", audience), attribute.Range); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerAudiencesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "HTTPS audience",
+			Content: `
+resource "okta_auth_server" "example" {
+  audiences = ["https://api.example.com"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "HTTP audience",
+			Content: `
+resource "okta_auth_server" "example" {
+  audiences = ["http://api.example.com"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerAudiencesRule(),
+					Message: "Audience http://api.example.com must use HTTPS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "Wildcard audience",
+			Content: `
+resource "okta_auth_server" "example" {
+  audiences = ["https://*.example.com"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerAudiencesRule(),
+					Message: "Audience https://*.example.com must not contain a wildcard",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "URN and API audiences",
+			Content: `
+resource "okta_auth_server" "example" {
+  audiences = ["urn:example:api", "api://default", "example-api"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "URN audience with a wildcard",
+			Content: `
+resource "okta_auth_server" "example" {
+  audiences = ["urn:example:*"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerAudiencesRule(),
+					Message: "Audience urn:example:* must not contain a wildcard",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "Allowed URN audience with a wildcard",
+			Content: `
+resource "okta_auth_server" "example" {
+  audiences = ["urn:example:*"]
+}`,
+			Config: `
+rule "okta_auth_server_audiences" {
+  enabled         = true
+  allowed_schemes = ["urn"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAuthServerAudiencesRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
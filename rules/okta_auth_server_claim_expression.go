@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAuthServerClaimExpressionRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	attributeName      string
+	valueTypeAttribute string
+	expressionType     string
+}
+
+// Functions extends the known top-level functions. UserAttributes and AppProfileAttributes, if set,
+// are the allowlists of attributes which user and app.profile references are checked against.
+type oktaAuthServerClaimExpressionRuleConfig struct {
+	Functions            []string `hclext:"functions,optional"`
+	UserAttributes       []string `hclext:"user_attributes,optional"`
+	AppProfileAttributes []string `hclext:"app_profile_attributes,optional"`
+}
+
+func NewOktaAuthServerClaimExpressionRule() *OktaAuthServerClaimExpressionRule {
+	return &OktaAuthServerClaimExpressionRule{
+		resourceType:       "okta_auth_server_claim",
+		attributeName:      "value",
+		valueTypeAttribute: "value_type",
+		expressionType:     "EXPRESSION",
+	}
+}
+
+func (r *OktaAuthServerClaimExpressionRule) Name() string {
+	return "okta_auth_server_claim_expression"
+}
+
+func (r *OktaAuthServerClaimExpressionRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerClaimExpressionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAuthServerClaimExpressionRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAuthServerClaimExpressionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	checker := newOktaExpressionChecker(config.Functions, map[string][]string{
+		"user":        config.UserAttributes,
+		"app.profile": config.AppProfileAttributes,
+	})
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.valueTypeAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		// Claims without a value_type are expressions.
+		valueType := r.expressionType
+		if valueTypeAttribute, exists := resource.Body.Attributes[r.valueTypeAttribute]; exists {
+			valueType = ""
+			err := runner.EvaluateExpr(valueTypeAttribute.Expr, func(value string) error {
+				valueType = value
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if valueType != r.expressionType {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(expression string) error {
+			for _, problem := range checker.problems(expression) {
+				if err := runner.EmitIssue(r, problem, attribute.Range); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerClaimExpressionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Valid expression",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value_type = "EXPRESSION"
+  value      = "String.toUpperCase(user.department)"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Boolean operator before a parenthesis",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value = "user.isAdmin AND (user.department == \"eng\")"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unbalanced expression without value type",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value = "String.toUpperCase(user.department"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerClaimExpressionRule(),
+					Message: `Expression has an unbalanced '('`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "Groups claim",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value_type = "GROUPS"
+  value      = "app-("
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unknown attributes",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value = "user.departmnet + app.profile.tenant"
+}`,
+			Config: `
+rule "okta_auth_server_claim_expression" {
+  enabled                = true
+  user_attributes        = ["department"]
+  app_profile_attributes = ["region"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerClaimExpressionRule(),
+					Message: "Expression references unknown attribute user.departmnet",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 49},
+					},
+				},
+				{
+					Rule:    NewOktaAuthServerClaimExpressionRule(),
+					Message: "Expression references unknown attribute app.profile.tenant",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 49},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAuthServerClaimExpressionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaAuthServerClaimGroupsFilterRule checks that groups claims filter the groups they include,
+// since unfiltered claims bloat tokens and disclose the names of internal groups.
+type OktaAuthServerClaimGroupsFilterRule struct {
+	tflint.DefaultRule
+	resourceType     string
+	filterAttribute  string
+	valueAttribute   string
+	matchAllPatterns []string
+}
+
+func NewOktaAuthServerClaimGroupsFilterRule() *OktaAuthServerClaimGroupsFilterRule {
+	return &OktaAuthServerClaimGroupsFilterRule{
+		resourceType:     "okta_auth_server_claim",
+		filterAttribute:  "group_filter_type",
+		valueAttribute:   "value",
+		matchAllPatterns: []string{".*", ".+", "^.*$", "^.+$", "^.*", "^.+", ".*$", ".+$"},
+	}
+}
+
+func (r *OktaAuthServerClaimGroupsFilterRule) Name() string {
+	return "okta_auth_server_claim_groups_filter"
+}
+
+func (r *OktaAuthServerClaimGroupsFilterRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerClaimGroupsFilterRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAuthServerClaimGroupsFilterRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.filterAttribute}, {Name: r.valueAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		filterAttribute, exists := resource.Body.Attributes[r.filterAttribute]
+		if !exists {
+			continue
+		}
+		valueAttribute, exists := resource.Body.Attributes[r.valueAttribute]
+		if !exists {
+			continue
+		}
+
+		filterType := ""
+		err := runner.EvaluateExpr(filterAttribute.Expr, func(value string) error {
+			filterType = value
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		err = runner.EvaluateExpr(valueAttribute.Expr, func(value string) error {
+			matchesAll := false
+			switch filterType {
+			case "REGEX":
+				matchesAll = slices.Contains(r.matchAllPatterns, strings.TrimSpace(value))
+			case "STARTS_WITH", "CONTAINS":
+				matchesAll = value == ""
+			}
+			if !matchesAll {
+				return nil
+			}
+			return runner.EmitIssue(r, fmt.Sprintf("Groups claim with %s %q includes every group, filter it to the groups the application needs", filterType, value), valueAttribute.Range)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerClaimGroupsFilterRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Scoped regular expression",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value_type        = "GROUPS"
+  group_filter_type = "REGEX"
+  value             = "^app-example-.*$"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Match-all regular expression",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value_type        = "GROUPS"
+  group_filter_type = "REGEX"
+  value             = ".*"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerClaimGroupsFilterRule(),
+					Message: `Groups claim with REGEX ".*" includes every group, filter it to the groups the application needs`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "Empty prefix",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value_type        = "GROUPS"
+  group_filter_type = "STARTS_WITH"
+  value             = ""
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerClaimGroupsFilterRule(),
+					Message: `Groups claim with STARTS_WITH "" includes every group, filter it to the groups the application needs`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "Scoped prefix",
+			Content: `
+resource "okta_auth_server_claim" "example" {
+  value_type        = "GROUPS"
+  group_filter_type = "STARTS_WITH"
+  value             = "app-example-"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAuthServerClaimGroupsFilterRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAuthServerCredentialsRotationRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	nameAttribute string
+}
+
+// Exempt lists the names of authorization servers whose keys are rotated manually.
+type oktaAuthServerCredentialsRotationRuleConfig struct {
+	Exempt []string `hclext:"exempt,optional"`
+}
+
+func NewOktaAuthServerCredentialsRotationRule() *OktaAuthServerCredentialsRotationRule {
+	return &OktaAuthServerCredentialsRotationRule{
+		resourceType:  "okta_auth_server",
+		attributeName: "credentials_rotation_mode",
+		nameAttribute: "name",
+	}
+}
+
+func (r *OktaAuthServerCredentialsRotationRule) Name() string {
+	return "okta_auth_server_credentials_rotation"
+}
+
+func (r *OktaAuthServerCredentialsRotationRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerCredentialsRotationRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAuthServerCredentialsRotationRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAuthServerCredentialsRotationRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.nameAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		exempt := false
+		if nameAttribute, exists := resource.Body.Attributes[r.nameAttribute]; exists && len(config.Exempt) > 0 {
+			err := runner.EvaluateExpr(nameAttribute.Expr, func(name string) error {
+				exempt = slices.Contains(config.Exempt, name)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if exempt {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(mode string) error {
+			if mode == "MANUAL" {
+				return runner.EmitIssue(r, fmt.Sprintf("%s should be %q, keys rotated manually are routinely left stale", r.attributeName, "AUTO"), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
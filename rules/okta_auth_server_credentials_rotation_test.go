@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerCredentialsRotationRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Automatic rotation",
+			Content: `
+resource "okta_auth_server" "example" {
+  name                      = "example"
+  credentials_rotation_mode = "AUTO"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Manual rotation",
+			Content: `
+resource "okta_auth_server" "example" {
+  name                      = "example"
+  credentials_rotation_mode = "MANUAL"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerCredentialsRotationRule(),
+					Message: `credentials_rotation_mode should be "AUTO", keys rotated manually are routinely left stale`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "Exempt authorization server",
+			Content: `
+resource "okta_auth_server" "example" {
+  name                      = "legacy"
+  credentials_rotation_mode = "MANUAL"
+}`,
+			Config: `
+rule "okta_auth_server_credentials_rotation" {
+  enabled = true
+  exempt  = ["legacy"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAuthServerCredentialsRotationRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
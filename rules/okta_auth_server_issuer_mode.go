@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaAuthServerIssuerModeRule checks that authorization servers only use a custom issuer URL
+// when the configuration defines a custom domain, since tokens otherwise have the default issuer.
+type OktaAuthServerIssuerModeRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	domainType    string
+	customMode    string
+}
+
+// CustomDomain confirms that a custom domain exists outside the configuration.
+type oktaAuthServerIssuerModeRuleConfig struct {
+	CustomDomain bool `hclext:"custom_domain,optional"`
+}
+
+func NewOktaAuthServerIssuerModeRule() *OktaAuthServerIssuerModeRule {
+	return &OktaAuthServerIssuerModeRule{
+		resourceType:  "okta_auth_server",
+		attributeName: "issuer_mode",
+		domainType:    "okta_domain",
+		customMode:    "CUSTOM_URL",
+	}
+}
+
+func (r *OktaAuthServerIssuerModeRule) Name() string {
+	return "okta_auth_server_issuer_mode"
+}
+
+func (r *OktaAuthServerIssuerModeRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerIssuerModeRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaAuthServerIssuerModeRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAuthServerIssuerModeRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if config.CustomDomain {
+		return nil
+	}
+
+	domains, err := runner.GetResourceContent(r.domainType, &hclext.BodySchema{}, nil)
+	if err != nil {
+		return err
+	}
+	if len(domains.Blocks) > 0 {
+		return nil
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(mode string) error {
+			if mode != r.customMode {
+				return nil
+			}
+			return runner.EmitIssue(r, fmt.Sprintf("%s is %q but no %s is configured, tokens will have the default issuer", r.attributeName, mode, r.domainType), attribute.Range)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerIssuerModeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Custom URL without custom domain",
+			Content: `
+resource "okta_auth_server" "example" {
+  issuer_mode = "CUSTOM_URL"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerIssuerModeRule(),
+					Message: `issuer_mode is "CUSTOM_URL" but no okta_domain is configured, tokens will have the default issuer`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "Custom URL with custom domain",
+			Content: `
+resource "okta_domain" "example" {
+  name = "login.example.com"
+}
+
+resource "okta_auth_server" "example" {
+  issuer_mode = "CUSTOM_URL"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Custom domain confirmed",
+			Content: `
+resource "okta_auth_server" "example" {
+  issuer_mode = "CUSTOM_URL"
+}`,
+			Config: `
+rule "okta_auth_server_issuer_mode" {
+  enabled       = true
+  custom_domain = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Default issuer",
+			Content: `
+resource "okta_auth_server" "example" {
+  issuer_mode = "ORG_URL"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAuthServerIssuerModeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
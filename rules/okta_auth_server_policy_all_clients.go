@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAuthServerPolicyAllClientsRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	allClients    string
+}
+
+func NewOktaAuthServerPolicyAllClientsRule() *OktaAuthServerPolicyAllClientsRule {
+	return &OktaAuthServerPolicyAllClientsRule{
+		resourceType:  "okta_auth_server_policy",
+		attributeName: "client_whitelist",
+		allClients:    "ALL_CLIENTS",
+	}
+}
+
+func (r *OktaAuthServerPolicyAllClientsRule) Name() string {
+	return "okta_auth_server_policy_all_clients"
+}
+
+func (r *OktaAuthServerPolicyAllClientsRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerPolicyAllClientsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAuthServerPolicyAllClientsRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(clients []string) error {
+			if slices.Contains(clients, r.allClients) {
+				return runner.EmitIssue(r, fmt.Sprintf("%s must list specific client IDs or okta_app_oauth references instead of %s", r.attributeName, r.allClients), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerPolicyAllClientsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "All clients",
+			Content: `
+resource "okta_auth_server_policy" "example" {
+  client_whitelist = ["ALL_CLIENTS"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyAllClientsRule(),
+					Message: "client_whitelist must list specific client IDs or okta_app_oauth references instead of ALL_CLIENTS",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "Specific clients",
+			Content: `
+resource "okta_auth_server_policy" "example" {
+  client_whitelist = ["0oa1234567890abcdef"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAuthServerPolicyAllClientsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaAuthServerPolicyRuleGrantTypeRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	denied        []string
+}
+
+// Denied lists the grant types which authorization server policy rules must not allow.
+type oktaAuthServerPolicyRuleGrantTypeRuleConfig struct {
+	Denied []string `hclext:"denied,optional"`
+}
+
+func NewOktaAuthServerPolicyRuleGrantTypeRule() *OktaAuthServerPolicyRuleGrantTypeRule {
+	return &OktaAuthServerPolicyRuleGrantTypeRule{
+		resourceType:  "okta_auth_server_policy_rule",
+		attributeName: "grant_type_whitelist",
+		denied:        []string{"implicit", "password"},
+	}
+}
+
+func (r *OktaAuthServerPolicyRuleGrantTypeRule) Name() string {
+	return "okta_auth_server_policy_rule_grant_type"
+}
+
+func (r *OktaAuthServerPolicyRuleGrantTypeRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerPolicyRuleGrantTypeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAuthServerPolicyRuleGrantTypeRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAuthServerPolicyRuleGrantTypeRuleConfig{Denied: r.denied}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(grantTypes []string) error {
+			for _, grantType := range grantTypes {
+				if !slices.Contains(config.Denied, grantType) {
+					continue
+				}
+				if err := runner.EmitIssue(r, fmt.Sprintf("Grant type %s must not be allowed", grantType), attribute.Range); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerPolicyRuleGrantTypeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Allowed grant types",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  grant_type_whitelist = ["authorization_code", "client_credentials"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Denied grant types",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  grant_type_whitelist = ["implicit", "authorization_code", "password"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleGrantTypeRule(),
+					Message: "Grant type implicit must not be allowed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 72},
+					},
+				},
+				{
+					Rule:    NewOktaAuthServerPolicyRuleGrantTypeRule(),
+					Message: "Grant type password must not be allowed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 72},
+					},
+				},
+			},
+		},
+		{
+			Name: "Custom denied grant types",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  grant_type_whitelist = ["implicit", "client_credentials"]
+}`,
+			Config: `
+rule "okta_auth_server_policy_rule_grant_type" {
+  enabled = true
+  denied  = ["client_credentials"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleGrantTypeRule(),
+					Message: "Grant type client_credentials must not be allowed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 60},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAuthServerPolicyRuleGrantTypeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaAuthServerPolicyRuleRefreshTokenRule checks that authorization server policy rules limit the lifetime
+// and idle window of refresh tokens, and that the window does not exceed the lifetime.
+// A lifetime of 0 means refresh tokens never expire.
+type OktaAuthServerPolicyRuleRefreshTokenRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	lifetimeAttribute string
+	windowAttribute   string
+	maxLifetime       int
+	maxWindow         int
+}
+
+// MaxLifetime and MaxWindow are the maximum refresh token lifetime and window in minutes.
+type oktaAuthServerPolicyRuleRefreshTokenRuleConfig struct {
+	MaxLifetime int `hclext:"max_lifetime,optional"`
+	MaxWindow   int `hclext:"max_window,optional"`
+}
+
+func NewOktaAuthServerPolicyRuleRefreshTokenRule() *OktaAuthServerPolicyRuleRefreshTokenRule {
+	return &OktaAuthServerPolicyRuleRefreshTokenRule{
+		resourceType:      "okta_auth_server_policy_rule",
+		lifetimeAttribute: "refresh_token_lifetime_minutes",
+		windowAttribute:   "refresh_token_window_minutes",
+		maxLifetime:       129600,
+		maxWindow:         10080,
+	}
+}
+
+func (r *OktaAuthServerPolicyRuleRefreshTokenRule) Name() string {
+	return "okta_auth_server_policy_rule_refresh_token"
+}
+
+func (r *OktaAuthServerPolicyRuleRefreshTokenRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerPolicyRuleRefreshTokenRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAuthServerPolicyRuleRefreshTokenRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAuthServerPolicyRuleRefreshTokenRuleConfig{MaxLifetime: r.maxLifetime, MaxWindow: r.maxWindow}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.lifetimeAttribute}, {Name: r.windowAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		lifetime := -1
+		if attribute, exists := resource.Body.Attributes[r.lifetimeAttribute]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(value int) error {
+				lifetime = value
+				if value == 0 {
+					return runner.EmitIssue(r, fmt.Sprintf("%s is 0, which is unlimited and exceeds the maximum of %d", r.lifetimeAttribute, config.MaxLifetime), attribute.Range)
+				}
+				if value > config.MaxLifetime {
+					return runner.EmitIssue(r, fmt.Sprintf("%s is %d, which exceeds the maximum of %d", r.lifetimeAttribute, value, config.MaxLifetime), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		attribute, exists := resource.Body.Attributes[r.windowAttribute]
+		if !exists {
+			continue
+		}
+		err := runner.EvaluateExpr(attribute.Expr, func(window int) error {
+			if window > config.MaxWindow {
+				if err := runner.EmitIssue(r, fmt.Sprintf("%s is %d, which exceeds the maximum of %d", r.windowAttribute, window, config.MaxWindow), attribute.Range); err != nil {
+					return err
+				}
+			}
+			if lifetime > 0 && window > lifetime {
+				return runner.EmitIssue(r, fmt.Sprintf("%s is %d, which exceeds %s of %d", r.windowAttribute, window, r.lifetimeAttribute, lifetime), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
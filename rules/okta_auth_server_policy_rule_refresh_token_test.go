@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaAuthServerPolicyRuleRefreshTokenRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Limited refresh tokens",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  refresh_token_lifetime_minutes = 43200
+  refresh_token_window_minutes   = 1440
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unlimited refresh token lifetime",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  refresh_token_lifetime_minutes = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleRefreshTokenRule(),
+					Message: "refresh_token_lifetime_minutes is 0, which is unlimited and exceeds the maximum of 129600",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "Long refresh token window",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  refresh_token_window_minutes = 20160
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleRefreshTokenRule(),
+					Message: "refresh_token_window_minutes is 20160, which exceeds the maximum of 10080",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "Refresh token window exceeds lifetime",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  refresh_token_lifetime_minutes = 1440
+  refresh_token_window_minutes   = 10080
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleRefreshTokenRule(),
+					Message: "refresh_token_window_minutes is 10080, which exceeds refresh_token_lifetime_minutes of 1440",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured maxima",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  refresh_token_lifetime_minutes = 43200
+  refresh_token_window_minutes   = 1440
+}`,
+			Config: `
+rule "okta_auth_server_policy_rule_refresh_token" {
+  enabled      = true
+  max_lifetime = 10080
+  max_window   = 720
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleRefreshTokenRule(),
+					Message: "refresh_token_lifetime_minutes is 43200, which exceeds the maximum of 10080",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+				{
+					Rule:    NewOktaAuthServerPolicyRuleRefreshTokenRule(),
+					Message: "refresh_token_window_minutes is 1440, which exceeds the maximum of 720",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 40},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAuthServerPolicyRuleRefreshTokenRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
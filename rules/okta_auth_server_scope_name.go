@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaAuthServerScopeNameRule checks that authorization server scope names match a format,
+// resource.action by default, and that scopes have a description.
+type OktaAuthServerScopeNameRule struct {
+	tflint.DefaultRule
+	resourceType         string
+	attributeName        string
+	descriptionAttribute string
+	format               string
+}
+
+type oktaAuthServerScopeNameRuleConfig struct {
+	Format string `hclext:"format,optional"`
+}
+
+func NewOktaAuthServerScopeNameRule() *OktaAuthServerScopeNameRule {
+	return &OktaAuthServerScopeNameRule{
+		resourceType:         "okta_auth_server_scope",
+		attributeName:        "name",
+		descriptionAttribute: "description",
+		format:               `^[a-z][a-z0-9_-]*\.[a-z][a-z0-9_-]*$`,
+	}
+}
+
+func (r *OktaAuthServerScopeNameRule) Name() string {
+	return "okta_auth_server_scope_name"
+}
+
+func (r *OktaAuthServerScopeNameRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaAuthServerScopeNameRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaAuthServerScopeNameRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaAuthServerScopeNameRuleConfig{Format: r.format}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	format, err := regexp.Compile(config.Format)
+	if err != nil {
+		return fmt.Errorf("invalid format for %s rule: %w", r.Name(), err)
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.descriptionAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	warning := &ruleWithSeverity{Rule: r, severity: tflint.WARNING}
+	descriptionMessage := "Authorization server scope should have a description"
+
+	for _, resource := range resources.Blocks {
+		if attribute, exists := resource.Body.Attributes[r.attributeName]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(scopeName string) error {
+				if !format.MatchString(scopeName) {
+					return runner.EmitIssue(r, fmt.Sprintf("Authorization server scope name %s does not match format %s", scopeName, config.Format), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		attribute, exists := resource.Body.Attributes[r.descriptionAttribute]
+		if !exists {
+			if err := runner.EmitIssue(warning, descriptionMessage, resource.DefRange); err != nil {
+				return err
+			}
+			continue
+		}
+		err := runner.EvaluateExpr(attribute.Expr, func(description string) error {
+			if strings.TrimSpace(description) == "" {
+				return runner.EmitIssue(warning, descriptionMessage, attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
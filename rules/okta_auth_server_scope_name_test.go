@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaAuthServerScopeNameRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Dot notation with description",
+			Content: `
+resource "okta_auth_server_scope" "example" {
+  name        = "orders.read"
+  description = "Read orders"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Name without dot notation",
+			Content: `
+resource "okta_auth_server_scope" "example" {
+  name        = "read_orders"
+  description = "Read orders"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerScopeNameRule(),
+					Message: `Authorization server scope name read_orders does not match format ^[a-z][a-z0-9_-]*\.[a-z][a-z0-9_-]*$`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Missing description",
+			Content: `
+resource "okta_auth_server_scope" "example" {
+  name = "orders.read"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAuthServerScopeNameRule(), severity: tflint.WARNING},
+					Message: "Authorization server scope should have a description",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 44},
+					},
+				},
+			},
+		},
+		{
+			Name: "Empty description",
+			Content: `
+resource "okta_auth_server_scope" "example" {
+  name        = "orders.read"
+  description = " "
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAuthServerScopeNameRule(), severity: tflint.WARNING},
+					Message: "Authorization server scope should have a description",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured format",
+			Content: `
+resource "okta_auth_server_scope" "example" {
+  name        = "orders:read"
+  description = "Read orders"
+}`,
+			Config: `
+rule "okta_auth_server_scope_name" {
+  enabled = true
+  format  = "^[a-z]+:[a-z]+$"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaAuthServerScopeNameRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaDeprecatedSkipArgumentsRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+	// replacements lists each deprecated argument, by resource type pattern, with the resource replacing it.
+	// They are checked in order, so issues are reported deterministically.
+	replacements []deprecatedArgument
+}
+
+type deprecatedArgument struct {
+	pattern     string
+	argument    string
+	replacement string
+}
+
+func NewOktaDeprecatedSkipArgumentsRule() *OktaDeprecatedSkipArgumentsRule {
+	return &OktaDeprecatedSkipArgumentsRule{
+		resourceTypes: []string{"okta_app_*", "okta_group"},
+		replacements: []deprecatedArgument{
+			{pattern: "okta_app_*", argument: "skip_users", replacement: "okta_app_user"},
+			{pattern: "okta_app_*", argument: "skip_groups", replacement: "okta_app_group_assignments"},
+			{pattern: "okta_group", argument: "skip_users", replacement: "okta_group_memberships"},
+		},
+	}
+}
+
+func (r *OktaDeprecatedSkipArgumentsRule) Name() string {
+	return "okta_deprecated_skip_arguments"
+}
+
+func (r *OktaDeprecatedSkipArgumentsRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaDeprecatedSkipArgumentsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaDeprecatedSkipArgumentsRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := getResourcesContent(runner, r.resourceTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "skip_users"}, {Name: "skip_groups"}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		for _, deprecated := range r.replacements {
+			if !matchesResourceType(resource.Labels[0], []string{deprecated.pattern}) {
+				continue
+			}
+			attribute, exists := resource.Body.Attributes[deprecated.argument]
+			if !exists {
+				continue
+			}
+			err = runner.EmitIssue(r, fmt.Sprintf("Argument %s is deprecated, manage assignments with %s resources instead", deprecated.argument, deprecated.replacement), attribute.Range)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
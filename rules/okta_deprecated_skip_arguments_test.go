@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaDeprecatedSkipArgumentsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "No deprecated arguments",
+			Content: `
+resource "okta_app_oauth" "example" {
+  label = "example"
+}
+
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Deprecated arguments on an application",
+			Content: `
+resource "okta_app_saml" "example" {
+  skip_users  = true
+  skip_groups = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaDeprecatedSkipArgumentsRule(),
+					Message: "Argument skip_users is deprecated, manage assignments with okta_app_user resources instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+				{
+					Rule:    NewOktaDeprecatedSkipArgumentsRule(),
+					Message: "Argument skip_groups is deprecated, manage assignments with okta_app_group_assignments resources instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "Deprecated argument on a group",
+			Content: `
+resource "okta_group" "example" {
+  skip_users = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaDeprecatedSkipArgumentsRule(),
+					Message: "Argument skip_users is deprecated, manage assignments with okta_group_memberships resources instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaDeprecatedSkipArgumentsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,173 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// oktaExpressionFunctions are the functions which may be called without a namespace.
+var oktaExpressionFunctions = []string{
+	"isMemberOfGroup",
+	"isMemberOfAnyGroup",
+	"isMemberOfGroupName",
+	"isMemberOfGroupNameStartsWith",
+	"isMemberOfGroupNameContains",
+	"isMemberOfGroupNameRegex",
+	"hasDirectoryUser",
+	"hasWorkdayUser",
+	"findDirectoryUser",
+	"findWorkdayUser",
+	"getFilteredGroups",
+}
+
+// oktaExpressionNamespaces are the objects whose functions may be called, e.g. String.stringContains().
+var oktaExpressionNamespaces = []string{
+	"Arrays",
+	"Convert",
+	"Groups",
+	"Iso3166Convert",
+	"String",
+	"Time",
+	"app",
+	"appuser",
+	"idpuser",
+	"org",
+	"user",
+}
+
+// oktaExpressionOperators are the keyword operators, which may precede a parenthesised expression
+// and are matched case-insensitively.
+var oktaExpressionOperators = []string{"AND", "OR", "NOT"}
+
+// oktaExpressionChecker finds obvious mistakes in Okta Expression Language expressions.
+// It is not a parser: it checks bracket balance, function names and attribute references.
+type oktaExpressionChecker struct {
+	functions  map[string]bool
+	namespaces map[string]bool
+	// attributes maps an object path, such as "user" or "app.profile", to its known attributes.
+	// Objects which are not in the map are not checked.
+	attributes map[string]map[string]bool
+}
+
+func newOktaExpressionChecker(functions []string, attributes map[string][]string) *oktaExpressionChecker {
+	checker := &oktaExpressionChecker{
+		functions:  map[string]bool{},
+		namespaces: map[string]bool{},
+		attributes: map[string]map[string]bool{},
+	}
+
+	for _, function := range oktaExpressionFunctions {
+		checker.functions[function] = true
+	}
+	for _, function := range functions {
+		checker.functions[function] = true
+	}
+	for _, namespace := range oktaExpressionNamespaces {
+		checker.namespaces[namespace] = true
+	}
+	for object, names := range attributes {
+		if len(names) == 0 {
+			continue
+		}
+		checker.attributes[object] = map[string]bool{}
+		for _, name := range names {
+			checker.attributes[object][name] = true
+		}
+	}
+
+	return checker
+}
+
+// problems returns a description of each problem found in the expression.
+func (c *oktaExpressionChecker) problems(expression string) []string {
+	var problems []string
+	var brackets []rune
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+	runes := []rune(expression)
+	for i := 0; i < len(runes); i++ {
+		char := runes[i]
+		switch {
+		case char == '"' || char == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != char {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(runes) {
+				return append(problems, "Expression has an unterminated string")
+			}
+			i = end
+
+		case char == '(' || char == '[' || char == '{':
+			brackets = append(brackets, char)
+
+		case closing[char] != 0:
+			if len(brackets) == 0 || brackets[len(brackets)-1] != closing[char] {
+				return append(problems, fmt.Sprintf("Expression has an unbalanced %q", char))
+			}
+			brackets = brackets[:len(brackets)-1]
+
+		case unicode.IsLetter(char) || char == '_' || char == '$':
+			end := i + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_' || runes[end] == '$' || runes[end] == '.') {
+				end++
+			}
+			name := strings.TrimRight(string(runes[i:end]), ".")
+
+			next := end
+			for next < len(runes) && unicode.IsSpace(runes[next]) {
+				next++
+			}
+			switch {
+			case isOktaExpressionOperator(name):
+				// Operators are neither function calls nor attribute references.
+			case next < len(runes) && runes[next] == '(':
+				problems = append(problems, c.checkFunction(name)...)
+			default:
+				problems = append(problems, c.checkReference(name)...)
+			}
+			i = end - 1
+		}
+	}
+
+	if len(brackets) > 0 {
+		problems = append(problems, fmt.Sprintf("Expression has an unbalanced %q", brackets[len(brackets)-1]))
+	}
+
+	return problems
+}
+
+func isOktaExpressionOperator(name string) bool {
+	for _, operator := range oktaExpressionOperators {
+		if strings.EqualFold(name, operator) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *oktaExpressionChecker) checkFunction(name string) []string {
+	namespace, _, namespaced := strings.Cut(name, ".")
+	if namespaced && c.namespaces[namespace] || !namespaced && c.functions[name] {
+		return nil
+	}
+	return []string{fmt.Sprintf("Expression calls unknown function %s", name)}
+}
+
+func (c *oktaExpressionChecker) checkReference(name string) []string {
+	for object, attributes := range c.attributes {
+		attribute, found := strings.CutPrefix(name, object+".")
+		if !found {
+			continue
+		}
+		attribute, _, _ = strings.Cut(attribute, ".")
+		if !attributes[attribute] {
+			return []string{fmt.Sprintf("Expression references unknown attribute %s.%s", object, attribute)}
+		}
+	}
+	return nil
+}
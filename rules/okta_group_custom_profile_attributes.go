@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupCustomProfileAttributesRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	keys          []string
+}
+
+type oktaGroupCustomProfileAttributesRuleConfig struct {
+	Keys []string `hclext:"keys,optional"`
+}
+
+func NewOktaGroupCustomProfileAttributesRule() *OktaGroupCustomProfileAttributesRule {
+	return &OktaGroupCustomProfileAttributesRule{
+		resourceType:  "okta_group",
+		attributeName: "custom_profile_attributes",
+		keys:          []string{"owner"},
+	}
+}
+
+func (r *OktaGroupCustomProfileAttributesRule) Name() string {
+	return "okta_group_custom_profile_attributes"
+}
+
+func (r *OktaGroupCustomProfileAttributesRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaGroupCustomProfileAttributesRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupCustomProfileAttributesRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupCustomProfileAttributesRuleConfig{Keys: r.keys}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			for _, key := range config.Keys {
+				err = runner.EmitIssue(r, fmt.Sprintf("Custom profile attribute %s is required", key), resource.DefRange)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(document string) error {
+			var value any
+			if err := json.Unmarshal([]byte(document), &value); err != nil {
+				return runner.EmitIssue(r, fmt.Sprintf("Custom profile attributes are not valid JSON: %s", err), attribute.Range)
+			}
+			profile, ok := value.(map[string]any)
+			if !ok {
+				return runner.EmitIssue(r, "Custom profile attributes must be a JSON object", attribute.Range)
+			}
+
+			for _, key := range config.Keys {
+				if _, exists := profile[key]; !exists {
+					err := runner.EmitIssue(r, fmt.Sprintf("Custom profile attribute %s is required", key), attribute.Range)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupCustomProfileAttributesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Required keys are present",
+			Content: `
+resource "okta_group" "example" {
+  custom_profile_attributes = "{\"owner\": \"alice\", \"costCenter\": \"42\"}"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Required key is missing",
+			Content: `
+resource "okta_group" "example" {
+  custom_profile_attributes = "{\"owner\": \"alice\"}"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupCustomProfileAttributesRule(),
+					Message: "Custom profile attribute costCenter is required",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			Name: "Custom profile attributes are missing",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupCustomProfileAttributesRule(),
+					Message: "Custom profile attribute owner is required",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+				{
+					Rule:    NewOktaGroupCustomProfileAttributesRule(),
+					Message: "Custom profile attribute costCenter is required",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "Custom profile attributes are invalid JSON",
+			Content: `
+resource "okta_group" "example" {
+  custom_profile_attributes = "{\"owner\": }"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupCustomProfileAttributesRule(),
+					Message: "Custom profile attributes are not valid JSON: invalid character '}' looking for beginning of value",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "Custom profile attributes are not a JSON object",
+			Content: `
+resource "okta_group" "example" {
+  custom_profile_attributes = "[]"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupCustomProfileAttributesRule(),
+					Message: "Custom profile attributes must be a JSON object",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 35},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_custom_profile_attributes" {
+  enabled = true
+  keys    = ["owner", "costCenter"]
+}`
+
+	rule := NewOktaGroupCustomProfileAttributesRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupDescriptionFormatRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	minLength     int
+}
+
+type oktaGroupDescriptionFormatRuleConfig struct {
+	MinLength int    `hclext:"min_length,optional"`
+	Format    string `hclext:"format,optional"`
+}
+
+func NewOktaGroupDescriptionFormatRule() *OktaGroupDescriptionFormatRule {
+	return &OktaGroupDescriptionFormatRule{
+		resourceType:  "okta_group",
+		attributeName: "description",
+		minLength:     10,
+	}
+}
+
+func (r *OktaGroupDescriptionFormatRule) Name() string {
+	return "okta_group_description_format"
+}
+
+func (r *OktaGroupDescriptionFormatRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaGroupDescriptionFormatRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaGroupDescriptionFormatRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupDescriptionFormatRuleConfig{MinLength: r.minLength}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var format *regexp.Regexp
+	if config.Format != "" {
+		var err error
+		format, err = regexp.Compile(config.Format)
+		if err != nil {
+			return fmt.Errorf("invalid format for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(description string) error {
+			length := utf8.RuneCountInString(description)
+			if length < config.MinLength {
+				err = runner.EmitIssue(r, fmt.Sprintf("Description is %d characters long, which is below the minimum of %d", length, config.MinLength), attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			if format != nil && !format.MatchString(description) {
+				err = runner.EmitIssue(r, fmt.Sprintf("Description does not match format %s", config.Format), attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
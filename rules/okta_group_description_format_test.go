@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupDescriptionFormatRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Description meets the default minimum length",
+			Content: `
+resource "okta_group" "example" {
+  description = "Payments engineers"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Description is below the default minimum length",
+			Content: `
+resource "okta_group" "example" {
+  description = "Payments"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupDescriptionFormatRule(),
+					Message: "Description is 8 characters long, which is below the minimum of 10",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupDescriptionFormatRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupDescriptionFormatRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Description has a ticket reference",
+			Content: `
+resource "okta_group" "example" {
+  description = "Payments engineers (SEC-123)"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Description is short and has no ticket reference",
+			Content: `
+resource "okta_group" "example" {
+  description = "Payments engineers"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupDescriptionFormatRule(),
+					Message: "Description is 18 characters long, which is below the minimum of 20",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+				{
+					Rule:    NewOktaGroupDescriptionFormatRule(),
+					Message: `Description does not match format \b[A-Z]+-[0-9]+\b`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_description_format" {
+  enabled    = true
+  min_length = 20
+  format     = "\\b[A-Z]+-[0-9]+\\b"
+}`
+
+	rule := NewOktaGroupDescriptionFormatRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupDescriptionRequiredRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+func NewOktaGroupDescriptionRequiredRule() *OktaGroupDescriptionRequiredRule {
+	return &OktaGroupDescriptionRequiredRule{
+		resourceType:  "okta_group",
+		attributeName: "description",
+	}
+}
+
+func (r *OktaGroupDescriptionRequiredRule) Name() string {
+	return "okta_group_description_required"
+}
+
+func (r *OktaGroupDescriptionRequiredRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaGroupDescriptionRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaGroupDescriptionRequiredRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	issueMessage := "Okta group should have a description"
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			err = runner.EmitIssue(r, issueMessage, resource.DefRange)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(description string) error {
+			if strings.TrimSpace(description) == "" {
+				err = runner.EmitIssue(r, issueMessage, attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
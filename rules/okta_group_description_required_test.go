@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupDescriptionRequiredRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group has a description",
+			Content: `
+resource "okta_group" "example" {
+  description = "Example group"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group has an empty description",
+			Content: `
+resource "okta_group" "example" {
+  description = " "
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupDescriptionRequiredRule(),
+					Message: "Okta group should have a description",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group has no description",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupDescriptionRequiredRule(),
+					Message: "Okta group should have a description",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupDescriptionRequiredRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupNameCharsetRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	allowed       string
+}
+
+// The allowed characters are the contents of a regular expression character
+// class, e.g. "a-z0-9-" for lowercase ASCII letters, digits and hyphens.
+type oktaGroupNameCharsetRuleConfig struct {
+	Allowed string `hclext:"allowed,optional"`
+}
+
+func NewOktaGroupNameCharsetRule() *OktaGroupNameCharsetRule {
+	return &OktaGroupNameCharsetRule{
+		resourceType:  "okta_group",
+		attributeName: "name",
+		allowed:       `a-z0-9._-`,
+	}
+}
+
+func (r *OktaGroupNameCharsetRule) Name() string {
+	return "okta_group_name_charset"
+}
+
+func (r *OktaGroupNameCharsetRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaGroupNameCharsetRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupNameCharsetRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupNameCharsetRuleConfig{Allowed: r.allowed}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	disallowed, err := regexp.Compile("[^" + config.Allowed + "]")
+	if err != nil {
+		return fmt.Errorf("invalid allowed characters for %s rule: %w", r.Name(), err)
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(groupName string) error {
+			character := disallowed.FindString(groupName)
+			if character != "" {
+				err = runner.EmitIssue(r, fmt.Sprintf("Okta group name contains disallowed character %q", character), attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
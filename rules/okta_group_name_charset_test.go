@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupNameCharsetRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name uses allowed characters",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example_1.0"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name contains whitespace",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameCharsetRule(),
+					Message: `Okta group name contains disallowed character " "`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group name contains uppercase letters",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-Example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameCharsetRule(),
+					Message: `Okta group name contains disallowed character "E"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group name contains non-ASCII characters",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-café"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameCharsetRule(),
+					Message: `Okta group name contains disallowed character "é"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNameCharsetRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNameCharsetRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name uses configured allowed characters",
+			Content: `
+resource "okta_group" "example" {
+  name = "Terraform-Example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name uses characters allowed by default but not by configuration",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform_example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameCharsetRule(),
+					Message: `Okta group name contains disallowed character "_"`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_name_charset" {
+  enabled = true
+  allowed = "A-Za-z-"
+}`
+
+	rule := NewOktaGroupNameCharsetRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
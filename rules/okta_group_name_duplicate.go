@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupNameDuplicateRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+func NewOktaGroupNameDuplicateRule() *OktaGroupNameDuplicateRule {
+	return &OktaGroupNameDuplicateRule{
+		resourceType:  "okta_group",
+		attributeName: "name",
+	}
+}
+
+func (r *OktaGroupNameDuplicateRule) Name() string {
+	return "okta_group_name_duplicate"
+}
+
+func (r *OktaGroupNameDuplicateRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaGroupNameDuplicateRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupNameDuplicateRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	declared := map[string]hcl.Range{}
+	// reported records group names whose first declaration has been reported.
+	reported := map[string]bool{}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(groupName string) error {
+			first, exists := declared[groupName]
+			if !exists {
+				declared[groupName] = attribute.Range
+				return nil
+			}
+			if !reported[groupName] {
+				reported[groupName] = true
+				err := runner.EmitIssue(r, fmt.Sprintf("Okta group name %s is declared again at %s:%d", groupName, attribute.Range.Filename, attribute.Range.Start.Line), first)
+				if err != nil {
+					return err
+				}
+			}
+			return runner.EmitIssue(r, fmt.Sprintf("Okta group name %s is already declared at %s:%d", groupName, first.Filename, first.Start.Line), attribute.Range)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupNameDuplicateRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group names are unique",
+			Content: `
+resource "okta_group" "one" {
+  name = "terraform-one"
+}
+
+resource "okta_group" "two" {
+  name = "terraform-two"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group names are duplicated",
+			Content: `
+resource "okta_group" "one" {
+  name = "terraform-one"
+}
+
+resource "okta_group" "two" {
+  name = "terraform-one"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameDuplicateRule(),
+					Message: "Okta group name terraform-one is declared again at resource.tf:7",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+				{
+					Rule:    NewOktaGroupNameDuplicateRule(),
+					Message: "Okta group name terraform-one is already declared at resource.tf:3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group name is declared three times",
+			Content: `
+resource "okta_group" "one" {
+  name = "terraform-one"
+}
+
+resource "okta_group" "two" {
+  name = "terraform-one"
+}
+
+resource "okta_group" "three" {
+  name = "terraform-one"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameDuplicateRule(),
+					Message: "Okta group name terraform-one is declared again at resource.tf:7",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+				{
+					Rule:    NewOktaGroupNameDuplicateRule(),
+					Message: "Okta group name terraform-one is already declared at resource.tf:3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+				{
+					Rule:    NewOktaGroupNameDuplicateRule(),
+					Message: "Okta group name terraform-one is already declared at resource.tf:3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 3},
+						End:      hcl.Pos{Line: 11, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNameDuplicateRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupNameEnvironmentSuffixRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	suffixes      []string
+}
+
+type oktaGroupNameEnvironmentSuffixRuleConfig struct {
+	Suffixes []string `hclext:"suffixes,optional"`
+}
+
+func NewOktaGroupNameEnvironmentSuffixRule() *OktaGroupNameEnvironmentSuffixRule {
+	return &OktaGroupNameEnvironmentSuffixRule{
+		resourceType:  "okta_group",
+		attributeName: "name",
+		suffixes:      []string{"-dev", "-stg", "-prod"},
+	}
+}
+
+func (r *OktaGroupNameEnvironmentSuffixRule) Name() string {
+	return "okta_group_name_environment_suffix"
+}
+
+func (r *OktaGroupNameEnvironmentSuffixRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaGroupNameEnvironmentSuffixRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupNameEnvironmentSuffixRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupNameEnvironmentSuffixRuleConfig{Suffixes: r.suffixes}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	issueMessage := fmt.Sprintf("Okta group name must end with one of '%s'", strings.Join(config.Suffixes, "', '"))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(groupName string) error {
+			for _, suffix := range config.Suffixes {
+				if strings.HasSuffix(groupName, suffix) {
+					return nil
+				}
+			}
+			return runner.EmitIssue(r, issueMessage, attribute.Range)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupNameEnvironmentSuffixRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has an environment suffix",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example-prod"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name lacks an environment suffix",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example-production"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameEnvironmentSuffixRule(),
+					Message: "Okta group name must end with one of '-dev', '-stg', '-prod'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 40},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNameEnvironmentSuffixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNameEnvironmentSuffixRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has a configured environment suffix",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example-qa"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name has a default but not configured environment suffix",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example-stg"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameEnvironmentSuffixRule(),
+					Message: "Okta group name must end with one of '-qa', '-live'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_name_environment_suffix" {
+  enabled  = true
+  suffixes = ["-qa", "-live"]
+}`
+
+	rule := NewOktaGroupNameEnvironmentSuffixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
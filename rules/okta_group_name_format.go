@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupNameFormatRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	format        string
+}
+
+type oktaGroupNameFormatRuleConfig struct {
+	Format string `hclext:"format,optional"`
+}
+
+func NewOktaGroupNameFormatRule() *OktaGroupNameFormatRule {
+	return &OktaGroupNameFormatRule{
+		resourceType:  "okta_group",
+		attributeName: "name",
+		format:        `^[a-z0-9]+(-[a-z0-9]+)*$`,
+	}
+}
+
+func (r *OktaGroupNameFormatRule) Name() string {
+	return "okta_group_name_format"
+}
+
+func (r *OktaGroupNameFormatRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaGroupNameFormatRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupNameFormatRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupNameFormatRuleConfig{Format: r.format}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	format, err := regexp.Compile(config.Format)
+	if err != nil {
+		return fmt.Errorf("invalid format for %s rule: %w", r.Name(), err)
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(groupName string) error {
+			if !format.MatchString(groupName) {
+				err = runner.EmitIssue(r, fmt.Sprintf("Okta group name %s does not match format %s", groupName, config.Format), attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
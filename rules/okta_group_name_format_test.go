@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupNameFormatRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name matches the default format",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example-1"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name does not match the default format",
+			Content: `
+resource "okta_group" "example" {
+  name = "Terraform_Example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameFormatRule(),
+					Message: "Okta group name Terraform_Example does not match format ^[a-z0-9]+(-[a-z0-9]+)*$",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNameFormatRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNameFormatRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name matches the configured format",
+			Content: `
+resource "okta_group" "example" {
+  name = "eng.payments.admins"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name does not match the configured format",
+			Content: `
+resource "okta_group" "example" {
+  name = "eng.payments"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameFormatRule(),
+					Message: `Okta group name eng.payments does not match format ^[a-z]+\.[a-z]+\.(admins|users)$`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_name_format" {
+  enabled = true
+  format  = "^[a-z]+\\.[a-z]+\\.(admins|users)$"
+}`
+
+	rule := NewOktaGroupNameFormatRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNameFormatRule_InvalidFormat(t *testing.T) {
+	config := `
+rule "okta_group_name_format" {
+  enabled = true
+  format  = "^[a-z"
+}`
+
+	rule := NewOktaGroupNameFormatRule()
+
+	runner := helper.TestRunner(t, map[string]string{"resource.tf": `resource "okta_group" "example" {}`, ".tflint.hcl": config})
+
+	if err := rule.Check(runner); err == nil {
+		t.Fatal("Expected an error for an invalid format")
+	}
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupNameLengthRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	max           int
+}
+
+type oktaGroupNameLengthRuleConfig struct {
+	Max int `hclext:"max,optional"`
+}
+
+func NewOktaGroupNameLengthRule() *OktaGroupNameLengthRule {
+	return &OktaGroupNameLengthRule{
+		resourceType:  "okta_group",
+		attributeName: "name",
+		max:           255,
+	}
+}
+
+func (r *OktaGroupNameLengthRule) Name() string {
+	return "okta_group_name_length"
+}
+
+func (r *OktaGroupNameLengthRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaGroupNameLengthRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupNameLengthRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupNameLengthRuleConfig{Max: r.max}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(groupName string) error {
+			length := utf8.RuneCountInString(groupName)
+			if length > config.Max {
+				err = runner.EmitIssue(r, fmt.Sprintf("Name is %d characters long, which exceeds the maximum of %d", length, config.Max), attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
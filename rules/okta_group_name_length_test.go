@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupNameLengthRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name is at the default maximum length",
+			Content: `
+resource "okta_group" "example" {
+  name = "` + strings.Repeat("a", 255) + `"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name exceeds the default maximum length",
+			Content: `
+resource "okta_group" "example" {
+  name = "` + strings.Repeat("a", 256) + `"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameLengthRule(),
+					Message: "Name is 256 characters long, which exceeds the maximum of 255",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 268},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNameLengthRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNameLengthRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name is within the configured maximum length",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name exceeds the configured maximum length",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-` + strings.Repeat("x", 60) + `"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameLengthRule(),
+					Message: "Name is 70 characters long, which exceeds the maximum of 64",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 82},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_name_length" {
+  enabled = true
+  max     = 64
+}`
+
+	rule := NewOktaGroupNameLengthRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupNameReservedWordsRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	words         []string
+}
+
+type oktaGroupNameReservedWordsRuleConfig struct {
+	Words []string `hclext:"words,optional"`
+}
+
+func NewOktaGroupNameReservedWordsRule() *OktaGroupNameReservedWordsRule {
+	return &OktaGroupNameReservedWordsRule{
+		resourceType:  "okta_group",
+		attributeName: "name",
+		words:         []string{"admin", "everyone", "okta"},
+	}
+}
+
+func (r *OktaGroupNameReservedWordsRule) Name() string {
+	return "okta_group_name_reserved_words"
+}
+
+func (r *OktaGroupNameReservedWordsRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaGroupNameReservedWordsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupNameReservedWordsRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupNameReservedWordsRuleConfig{Words: r.words}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(groupName string) error {
+			for _, word := range config.Words {
+				if strings.Contains(strings.ToLower(groupName), strings.ToLower(word)) {
+					err = runner.EmitIssue(r, fmt.Sprintf("Okta group name contains reserved word %s", word), attribute.Range)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
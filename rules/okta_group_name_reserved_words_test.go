@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupNameReservedWordsRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has no reserved words",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name has reserved words in any case",
+			Content: `
+resource "okta_group" "example" {
+  name = "Okta-Admins"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameReservedWordsRule(),
+					Message: "Okta group name contains reserved word admin",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+				{
+					Rule:    NewOktaGroupNameReservedWordsRule(),
+					Message: "Okta group name contains reserved word okta",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNameReservedWordsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNameReservedWordsRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has a default but not configured reserved word",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-admins"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name has a configured reserved word",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-root"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNameReservedWordsRule(),
+					Message: "Okta group name contains reserved word root",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_name_reserved_words" {
+  enabled = true
+  words   = ["root"]
+}`
+
+	rule := NewOktaGroupNameReservedWordsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
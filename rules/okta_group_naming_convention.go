@@ -4,32 +4,113 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 )
 
+// leadingTemplateText evaluates the parts of a template expression one by one,
+// returning the text before the first unknown part and whether any part is known.
+func leadingTemplateText(runner tflint.Runner, expr hcl.Expression) (string, bool, error) {
+	template, ok := expr.(*hclsyntax.TemplateExpr)
+	if !ok {
+		return "", false, nil
+	}
+
+	leading := strings.Builder{}
+	leadingKnown := true
+	anyKnown := false
+
+	for _, part := range template.Parts {
+		text, known := "", false
+		if literal, ok := part.(*hclsyntax.LiteralValueExpr); ok && literal.Val.Type() == cty.String {
+			text, known = literal.Val.AsString(), true
+		} else {
+			err := runner.EvaluateExpr(part, func(value string) error {
+				text, known = value, true
+				return nil
+			}, nil)
+			if err != nil {
+				return "", false, err
+			}
+		}
+
+		anyKnown = anyKnown || known
+		leadingKnown = leadingKnown && known
+		if leadingKnown {
+			leading.WriteString(text)
+		}
+	}
+
+	return leading.String(), anyKnown, nil
+}
+
 // OktaGroupNamePrefixRule checks if the 'name' attribute of an okta_group resource
-// starts with the required prefix "terraform-".
+// starts with a required prefix, "terraform-" unless configured otherwise.
+// The same rule checks okta_group_rule resources, sharing the okta_group_name_prefix
+// rule block configuration so that groups and group rules follow one convention.
 type OktaGroupNamePrefixRule struct {
 	tflint.DefaultRule
+	name          string
+	noun          string
 	resourceType  string
 	attributeName string
 	prefix        string
 }
 
+// groupNamePrefixConfigRuleName is the rule block holding the configuration shared by the prefix rules.
+const groupNamePrefixConfigRuleName = "okta_group_name_prefix"
+
+// oktaGroupNamePrefixRuleConfig is the optional rule block configuration in .tflint.hcl.
+// Teams maps the value of the resource's TeamAttribute to the prefix required for that team.
+type oktaGroupNamePrefixRuleConfig struct {
+	Prefix        string            `hclext:"prefix,optional"`
+	Prefixes      []string          `hclext:"prefixes,optional"`
+	TeamAttribute string            `hclext:"team_attribute,optional"`
+	Teams         map[string]string `hclext:"teams,optional"`
+
+	// EvaluateTemplates checks the known leading text of names that cannot be fully evaluated.
+	EvaluateTemplates bool `hclext:"evaluate_templates,optional"`
+	// NoticeUnknown emits a notice for names with no known text at all.
+	NoticeUnknown bool `hclext:"notice_unknown,optional"`
+}
+
+// allowedPrefixes returns the configured list of prefixes, or the single prefix if no list is set.
+func (c *oktaGroupNamePrefixRuleConfig) allowedPrefixes() []string {
+	if len(c.Prefixes) > 0 {
+		return c.Prefixes
+	}
+	return []string{c.Prefix}
+}
+
 // NewOktaGroupNamePrefixRule creates a new instance of the rule with defined constraints.
 func NewOktaGroupNamePrefixRule() *OktaGroupNamePrefixRule {
 	return &OktaGroupNamePrefixRule{
+		name:          "okta_group_name_prefix",
+		noun:          "Okta group",
 		resourceType:  "okta_group",
 		attributeName: "name",
 		prefix:        "terraform-",
 	}
 }
 
+// NewOktaGroupRuleNamePrefixRule creates an instance of the rule for okta_group_rule resources.
+func NewOktaGroupRuleNamePrefixRule() *OktaGroupNamePrefixRule {
+	return &OktaGroupNamePrefixRule{
+		name:          "okta_group_rule_name_prefix",
+		noun:          "Okta group rule",
+		resourceType:  "okta_group_rule",
+		attributeName: "name",
+		prefix:        "terraform-",
+	}
+}
+
 // Name returns the rule's name.
 func (r *OktaGroupNamePrefixRule) Name() string {
-	return "okta_group_name_prefix"
+	return r.name
 }
 
 // Enabled returns whether the rule is enabled by default.
@@ -46,15 +127,25 @@ func (r *OktaGroupNamePrefixRule) Severity() tflint.Severity {
 func (r *OktaGroupNamePrefixRule) Check(runner tflint.Runner) error {
 	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
 
-	// 1. Get all okta_group resources, requesting only the 'name' attribute.
+	// 1. Decode the shared rule configuration, falling back to the default prefix.
+	config := oktaGroupNamePrefixRuleConfig{Prefix: r.prefix}
+	if err := runner.DecodeRuleConfig(groupNamePrefixConfigRuleName, &config); err != nil {
+		return err
+	}
+
+	// 2. Get all resources of the rule's type, requesting the 'name' attribute and the team attribute, if any.
+	attributes := []hclext.AttributeSchema{{Name: r.attributeName}}
+	if config.TeamAttribute != "" {
+		attributes = append(attributes, hclext.AttributeSchema{Name: config.TeamAttribute})
+	}
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+		Attributes: attributes,
 	}, nil)
 	if err != nil {
 		return err
 	}
 
-	// 2. Iterate through each okta_group resource block found.
+	// 3. Iterate through each resource block found.
 	for _, resource := range resources.Blocks {
 		attribute, exists := resource.Body.Attributes[r.attributeName]
 		if !exists {
@@ -62,24 +153,97 @@ func (r *OktaGroupNamePrefixRule) Check(runner tflint.Runner) error {
 			continue
 		}
 
-		// 3. Evaluate the attribute's HCL expression to get the string value.
+		// 4. Narrow the allowed prefixes to the team's prefix when the team is known.
+		prefixes := config.allowedPrefixes()
+		team := ""
+		if teamAttribute, exists := resource.Body.Attributes[config.TeamAttribute]; exists && config.TeamAttribute != "" {
+			err := runner.EvaluateExpr(teamAttribute.Expr, func(value string) error {
+				if prefix, exists := config.Teams[value]; exists {
+					team = value
+					prefixes = []string{prefix}
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 5. Evaluate the attribute's HCL expression to get the string value.
+		evaluated := false
 		err := runner.EvaluateExpr(attribute.Expr, func(groupName string) error {
-			// 4. Check if the string value starts with the required prefix.
-			if !strings.HasPrefix(groupName, r.prefix) {
-				// 5. If it does not start with the prefix, emit an issue (error).
-				issueMsg := fmt.Sprintf("Okta group name must start with '%s'", r.prefix)
-				err = runner.EmitIssue(r, issueMsg, attribute.Range)
-				if err != nil {
-					return err
+			evaluated = true
+
+			// 6. Check if the string value starts with any of the allowed prefixes.
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(groupName, prefix) {
+					return nil
 				}
 			}
-			return nil
+
+			// 7. If it does not start with an allowed prefix, emit an issue (error),
+			// fixable by prepending the first allowed prefix to a literal name.
+			return runner.EmitIssueWithFix(r, r.issueMessage(team, prefixes), attribute.Range, func(f tflint.Fixer) error {
+				if !isStringLiteral(attribute.Expr) {
+					return tflint.ErrFixNotSupported
+				}
+				return f.ReplaceText(attribute.Expr.Range(), f.ValueText(cty.StringVal(prefixes[0]+groupName)))
+			})
 		}, nil)
 
 		if err != nil {
 			return err
 		}
+
+		// 8. If the name is not fully known, optionally check what is known of it instead.
+		if !evaluated && config.EvaluateTemplates {
+			if err := r.checkUnknown(runner, config, attribute, team, prefixes); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
+
+// checkUnknown checks the known leading text of a name which could not be evaluated.
+func (r *OktaGroupNamePrefixRule) checkUnknown(runner tflint.Runner, config oktaGroupNamePrefixRuleConfig, attribute *hclext.Attribute, team string, prefixes []string) error {
+	leading, anyKnown, err := leadingTemplateText(runner, attribute.Expr)
+	if err != nil {
+		return err
+	}
+
+	if !anyKnown {
+		if !config.NoticeUnknown {
+			return nil
+		}
+		notice := &ruleWithSeverity{Rule: r, severity: tflint.NOTICE}
+		return runner.EmitIssue(notice, r.noun+" name is unknown, so its prefix cannot be verified", attribute.Range)
+	}
+
+	possible := false
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(leading, prefix) {
+			return nil
+		}
+		possible = possible || strings.HasPrefix(prefix, leading)
+	}
+
+	if possible {
+		warning := &ruleWithSeverity{Rule: r, severity: tflint.WARNING}
+		return runner.EmitIssue(warning, r.noun+" name starts with an unknown value, so its prefix cannot be verified", attribute.Range)
+	}
+
+	return runner.EmitIssue(r, r.issueMessage(team, prefixes), attribute.Range)
+}
+
+// issueMessage describes the prefixes a group name must start with.
+func (r *OktaGroupNamePrefixRule) issueMessage(team string, prefixes []string) string {
+	if team != "" {
+		return fmt.Sprintf("%s name for team '%s' must start with '%s'", r.noun, team, prefixes[0])
+	}
+	if len(prefixes) == 1 {
+		return fmt.Sprintf("%s name must start with '%s'", r.noun, prefixes[0])
+	}
+	return fmt.Sprintf("%s name must start with one of '%s'", r.noun, strings.Join(prefixes, "', '"))
+}
@@ -0,0 +1,462 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// unknownVariableRunner treats references to var.unknown as unknown values,
+// which helper.Runner cannot represent, by skipping the evaluation callback.
+type unknownVariableRunner struct {
+	*helper.Runner
+}
+
+func (r *unknownVariableRunner) EvaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() == "var" && len(traversal) > 1 {
+			if attribute, ok := traversal[1].(hcl.TraverseAttr); ok && attribute.Name == "unknown" {
+				return nil
+			}
+		}
+	}
+	return r.Runner.EvaluateExpr(expr, target, opts)
+}
+
+func Test_OktaGroupNamePrefixRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has the default prefix",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name lacks the default prefix",
+			Content: `
+resource "okta_group" "example" {
+  name = "example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNamePrefixRule(),
+					Message: "Okta group name must start with 'terraform-'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNamePrefixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNamePrefixRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has the configured prefix",
+			Content: `
+resource "okta_group" "example" {
+  name = "iac-example"
+}`,
+			Config: `
+rule "okta_group_name_prefix" {
+  enabled = true
+  prefix  = "iac-"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name has the default prefix but not the configured prefix",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			Config: `
+rule "okta_group_name_prefix" {
+  enabled = true
+  prefix  = "iac-"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNamePrefixRule(),
+					Message: "Okta group name must start with 'iac-'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupNamePrefixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNamePrefixRule_Prefixes(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has one of the configured prefixes",
+			Content: `
+resource "okta_group" "example" {
+  name = "eng-example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name has none of the configured prefixes",
+			Content: `
+resource "okta_group" "example" {
+  name = "ops-example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNamePrefixRule(),
+					Message: "Okta group name must start with one of 'sec-', 'eng-', 'it-'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_name_prefix" {
+  enabled  = true
+  prefixes = ["sec-", "eng-", "it-"]
+}`
+
+	rule := NewOktaGroupNamePrefixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNamePrefixRule_Teams(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group name has the team's prefix",
+			Content: `
+resource "okta_group" "example" {
+  name        = "sec-example"
+  description = "security"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group name has another team's prefix",
+			Content: `
+resource "okta_group" "example" {
+  name        = "eng-example"
+  description = "security"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNamePrefixRule(),
+					Message: "Okta group name for team 'security' must start with 'sec-'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Unmapped team falls back to the configured prefixes",
+			Content: `
+resource "okta_group" "example" {
+  name        = "it-example"
+  description = "helpdesk"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	config := `
+rule "okta_group_name_prefix" {
+  enabled        = true
+  prefixes       = ["sec-", "eng-", "it-"]
+  team_attribute = "description"
+  teams = {
+    security    = "sec-"
+    engineering = "eng-"
+  }
+}`
+
+	rule := NewOktaGroupNamePrefixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupNamePrefixRule_Fix(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected map[string]string
+	}{
+		{
+			Name: "Literal group name is fixed",
+			Content: `
+resource "okta_group" "example" {
+  name = "example"
+}`,
+			Expected: map[string]string{
+				"resource.tf": `
+resource "okta_group" "example" {
+  name = "terraform-example"
+}`,
+			},
+		},
+		{
+			Name: "Interpolated group name is not fixed",
+			Content: `
+variable "team" {
+  default = "example"
+}
+
+resource "okta_group" "example" {
+  name = "${var.team}-group"
+}`,
+			Expected: map[string]string{},
+		},
+	}
+
+	rule := NewOktaGroupNamePrefixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertChanges(t, tc.Expected, runner.Changes())
+	}
+}
+
+func Test_OktaGroupNamePrefixRule_Unknown(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Known leading text has the prefix",
+			Content: `
+resource "okta_group" "example" {
+  name = "terraform-${var.unknown}"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Known leading text lacks the prefix",
+			Content: `
+resource "okta_group" "example" {
+  name = "example-${var.unknown}"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupNamePrefixRule(),
+					Message: "Okta group name must start with 'terraform-'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "Leading text is unknown",
+			Content: `
+resource "okta_group" "example" {
+  name = "${var.unknown}-example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaGroupNamePrefixRule(), severity: tflint.WARNING},
+					Message: "Okta group name starts with an unknown value, so its prefix cannot be verified",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "Name is entirely unknown",
+			Content: `
+resource "okta_group" "example" {
+  name = var.unknown
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaGroupNamePrefixRule(), severity: tflint.NOTICE},
+					Message: "Okta group name is unknown, so its prefix cannot be verified",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_name_prefix" {
+  enabled            = true
+  evaluate_templates = true
+  notice_unknown     = true
+}`
+
+	rule := NewOktaGroupNamePrefixRule()
+
+	for _, tc := range cases {
+		runner := &unknownVariableRunner{helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})}
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+		for i, issue := range runner.Issues {
+			if issue.Rule.Severity() != tc.Expected[i].Rule.Severity() {
+				t.Fatalf("Expected severity %s, got %s", tc.Expected[i].Rule.Severity(), issue.Rule.Severity())
+			}
+		}
+	}
+}
+
+func Test_OktaGroupNamePrefixRule_UnknownSkipped(t *testing.T) {
+	rule := NewOktaGroupNamePrefixRule()
+
+	runner := &unknownVariableRunner{helper.TestRunner(t, map[string]string{"resource.tf": `
+resource "okta_group" "example" {
+  name = "${var.unknown}-example"
+}`})}
+
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssues(t, helper.Issues{}, runner.Issues)
+}
+
+func Test_OktaGroupRuleNamePrefixRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group rule name has the default prefix",
+			Content: `
+resource "okta_group_rule" "example" {
+  name = "terraform-example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group rule name lacks the prefix shared with groups",
+			Content: `
+resource "okta_group_rule" "example" {
+  name = "terraform-example"
+}`,
+			Config: `
+rule "okta_group_name_prefix" {
+  enabled = true
+  prefix  = "iac-"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleNamePrefixRule(),
+					Message: "Okta group rule name must start with 'iac-'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupRuleNamePrefixRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
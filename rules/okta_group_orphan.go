@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupOrphanRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	resourceTypes []string
+}
+
+// ResourceTypes are the types of resources whose references to a group count as a use of it.
+type oktaGroupOrphanRuleConfig struct {
+	ResourceTypes []string `hclext:"resource_types,optional"`
+}
+
+func NewOktaGroupOrphanRule() *OktaGroupOrphanRule {
+	return &OktaGroupOrphanRule{
+		resourceType: "okta_group",
+		resourceTypes: []string{
+			"okta_group_rule",
+			"okta_group_memberships",
+			"okta_app_group_assignment",
+			"okta_app_group_assignments",
+			"okta_policy_*",
+		},
+	}
+}
+
+func (r *OktaGroupOrphanRule) Name() string {
+	return "okta_group_orphan"
+}
+
+func (r *OktaGroupOrphanRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaGroupOrphanRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaGroupOrphanRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupOrphanRuleConfig{ResourceTypes: r.resourceTypes}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, nil)
+	if err != nil {
+		return err
+	}
+	if len(resources.Blocks) == 0 {
+		return nil
+	}
+
+	index, err := newReferenceIndex(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		address := fmt.Sprintf("%s.%s", resource.Labels[0], resource.Labels[1])
+		if !index.referencedBy(address, config.ResourceTypes) {
+			err = runner.EmitIssue(r, fmt.Sprintf("Okta group %s is not referenced by any group rule, membership, assignment or policy", address), resource.DefRange)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
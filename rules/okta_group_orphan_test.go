@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupOrphanRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Groups are referenced",
+			Content: `
+resource "okta_group" "one" {}
+
+resource "okta_group" "two" {}
+
+resource "okta_group_memberships" "one" {
+  group_id = okta_group.one.id
+}
+
+resource "okta_policy_rule_signon" "two" {
+  groups_included = [okta_group.two.id]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group is referenced from a nested block",
+			Content: `
+resource "okta_group" "example" {}
+
+resource "okta_app_group_assignments" "example" {
+  group {
+    id = okta_group.example.id
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group is only referenced by other resource types",
+			Content: `
+resource "okta_group" "example" {}
+
+resource "okta_group_role" "example" {
+  group_id = okta_group.example.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupOrphanRule(),
+					Message: "Okta group okta_group.example is not referenced by any group rule, membership, assignment or policy",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group is referenced by a configured resource type",
+			Content: `
+resource "okta_group" "example" {}
+
+resource "okta_group_role" "example" {
+  group_id = okta_group.example.id
+}`,
+			Config: `
+rule "okta_group_orphan" {
+  enabled        = true
+  resource_types = ["okta_group_role"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaGroupOrphanRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
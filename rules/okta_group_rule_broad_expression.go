@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupRuleBroadExpressionRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	expressionName    string
+	usersExcludedName string
+	patterns          []string
+}
+
+// Patterns are regular expressions matching expressions which capture most or all users.
+type oktaGroupRuleBroadExpressionRuleConfig struct {
+	Patterns []string `hclext:"patterns,optional"`
+}
+
+func NewOktaGroupRuleBroadExpressionRule() *OktaGroupRuleBroadExpressionRule {
+	return &OktaGroupRuleBroadExpressionRule{
+		resourceType:      "okta_group_rule",
+		expressionName:    "expression_value",
+		usersExcludedName: "users_excluded",
+		patterns:          []string{`\btrue\b`, `user\.\w+\s*!=\s*null`},
+	}
+}
+
+func (r *OktaGroupRuleBroadExpressionRule) Name() string {
+	return "okta_group_rule_broad_expression"
+}
+
+func (r *OktaGroupRuleBroadExpressionRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaGroupRuleBroadExpressionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+func (r *OktaGroupRuleBroadExpressionRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupRuleBroadExpressionRuleConfig{Patterns: r.patterns}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	patterns := make([]*regexp.Regexp, len(config.Patterns))
+	for i, pattern := range config.Patterns {
+		var err error
+		patterns[i], err = regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.expressionName}, {Name: r.usersExcludedName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.expressionName]
+		if !exists {
+			continue
+		}
+
+		if usersExcluded, exists := resource.Body.Attributes[r.usersExcludedName]; exists {
+			excludes := true
+			err := runner.EvaluateExpr(usersExcluded.Expr, func(users []string) error {
+				excludes = len(users) > 0
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+			if excludes {
+				continue
+			}
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(expression string) error {
+			for _, pattern := range patterns {
+				if pattern.MatchString(expression) {
+					return runner.EmitIssue(r, "Broad group rule should exclude service accounts with users_excluded", attribute.Range)
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
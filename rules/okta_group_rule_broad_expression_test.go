@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupRuleBroadExpressionRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Narrow group rule has no users excluded",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "user.department == \"Eng\""
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Broad group rule has users excluded",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "true"
+  users_excluded   = ["00u1234567890"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Broad group rule has no users excluded",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "true"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleBroadExpressionRule(),
+					Message: "Broad group rule should exclude service accounts with users_excluded",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Broad group rule has an empty list of users excluded",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "user.email != null"
+  users_excluded   = []
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleBroadExpressionRule(),
+					Message: "Broad group rule should exclude service accounts with users_excluded",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group rule matches a configured pattern",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "String.stringContains(user.email, \"@\")"
+}`,
+			Config: `
+rule "okta_group_rule_broad_expression" {
+  enabled  = true
+  patterns = ["stringContains\\(user\\.email, \"@\"\\)"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleBroadExpressionRule(),
+					Message: "Broad group rule should exclude service accounts with users_excluded",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 64},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupRuleBroadExpressionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupRuleExpressionRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// Functions extends the known top-level functions. UserAttributes, if set,
+// is the allowlist of attributes which user references are checked against.
+type oktaGroupRuleExpressionRuleConfig struct {
+	Functions      []string `hclext:"functions,optional"`
+	UserAttributes []string `hclext:"user_attributes,optional"`
+}
+
+func NewOktaGroupRuleExpressionRule() *OktaGroupRuleExpressionRule {
+	return &OktaGroupRuleExpressionRule{
+		resourceType:  "okta_group_rule",
+		attributeName: "expression_value",
+	}
+}
+
+func (r *OktaGroupRuleExpressionRule) Name() string {
+	return "okta_group_rule_expression"
+}
+
+func (r *OktaGroupRuleExpressionRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaGroupRuleExpressionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaGroupRuleExpressionRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupRuleExpressionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	checker := newOktaExpressionChecker(config.Functions, map[string][]string{"user": config.UserAttributes})
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(expression string) error {
+			for _, problem := range checker.problems(expression) {
+				err = runner.EmitIssue(r, problem, attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupRuleExpressionRule_Default(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Expression is valid",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "String.startsWith(user.department, \"Eng (\") AND isMemberOfGroupName(\"all\")"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Expression uses AND before a parenthesis",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "user.department == \"eng\" AND (user.title == \"x\")"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Expression uses or before a parenthesis",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "user.department == \"eng\" or (user.title == \"x\")"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Expression uses NOT before a parenthesis",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "NOT (user.department == \"eng\")"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Expression has an unclosed parenthesis",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "String.startsWith(user.department, \"Eng\""
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleExpressionRule(),
+					Message: `Expression has an unbalanced '('`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 66},
+					},
+				},
+			},
+		},
+		{
+			Name: "Expression has an extra parenthesis",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "user.department == \"Eng\")"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleExpressionRule(),
+					Message: `Expression has an unbalanced ')'`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "Expression has an unterminated string",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "user.department == \"Eng"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleExpressionRule(),
+					Message: "Expression has an unterminated string",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "Expression calls unknown functions",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "isMemberOfGroupNames(\"all\") OR Strings.startsWith(user.department, \"Eng\")"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleExpressionRule(),
+					Message: "Expression calls unknown function isMemberOfGroupNames",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 101},
+					},
+				},
+				{
+					Rule:    NewOktaGroupRuleExpressionRule(),
+					Message: "Expression calls unknown function Strings.startsWith",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 101},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaGroupRuleExpressionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaGroupRuleExpressionRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Expression uses configured functions and attributes",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "customFunction(user.department) AND user.costCenter == \"42\""
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Expression references an unknown user attribute",
+			Content: `
+resource "okta_group_rule" "example" {
+  expression_value = "user.departmnt == \"Eng\""
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleExpressionRule(),
+					Message: "Expression references unknown attribute user.departmnt",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 49},
+					},
+				},
+			},
+		},
+	}
+
+	config := `
+rule "okta_group_rule_expression" {
+  enabled         = true
+  functions       = ["customFunction"]
+  user_attributes = ["department", "costCenter"]
+}`
+
+	rule := NewOktaGroupRuleExpressionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
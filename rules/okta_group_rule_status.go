@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaGroupRuleStatusRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	nameAttribute string
+}
+
+// Exclude is a regular expression matching the names of group rules which may be inactive.
+type oktaGroupRuleStatusRuleConfig struct {
+	Exclude string `hclext:"exclude,optional"`
+}
+
+func NewOktaGroupRuleStatusRule() *OktaGroupRuleStatusRule {
+	return &OktaGroupRuleStatusRule{
+		resourceType:  "okta_group_rule",
+		attributeName: "status",
+		nameAttribute: "name",
+	}
+}
+
+func (r *OktaGroupRuleStatusRule) Name() string {
+	return "okta_group_rule_status"
+}
+
+func (r *OktaGroupRuleStatusRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaGroupRuleStatusRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaGroupRuleStatusRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaGroupRuleStatusRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var exclude *regexp.Regexp
+	if config.Exclude != "" {
+		var err error
+		exclude, err = regexp.Compile(config.Exclude)
+		if err != nil {
+			return fmt.Errorf("invalid exclude pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}, {Name: r.nameAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		excluded := false
+		if nameAttribute, exists := resource.Body.Attributes[r.nameAttribute]; exists && exclude != nil {
+			err := runner.EvaluateExpr(nameAttribute.Expr, func(name string) error {
+				excluded = exclude.MatchString(name)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if excluded {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(status string) error {
+			if status == "INACTIVE" {
+				err = runner.EmitIssue(r, "Inactive group rule should be removed or activated", attribute.Range)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
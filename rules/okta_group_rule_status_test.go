@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaGroupRuleStatusRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Group rule is active",
+			Content: `
+resource "okta_group_rule" "example" {
+  name   = "terraform-example"
+  status = "ACTIVE"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Group rule is inactive",
+			Content: `
+resource "okta_group_rule" "example" {
+  name   = "terraform-example"
+  status = "INACTIVE"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaGroupRuleStatusRule(),
+					Message: "Inactive group rule should be removed or activated",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "Inactive group rule is excluded",
+			Content: `
+resource "okta_group_rule" "example" {
+  name   = "terraform-staged-example"
+  status = "INACTIVE"
+}`,
+			Config: `
+rule "okta_group_rule_status" {
+  enabled = true
+  exclude = "^terraform-staged-"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaGroupRuleStatusRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// namingCases maps the supported values of a naming convention's case to the pattern they enforce.
+var namingCases = map[string]*regexp.Regexp{
+	"lower": regexp.MustCompile(`^[^A-Z]*$`),
+	"upper": regexp.MustCompile(`^[^a-z]*$`),
+	"kebab": regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	"snake": regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+}
+
+// NamingConvention is a naming_convention block in the plugin configuration.
+type NamingConvention struct {
+	ResourceType string `hclext:"resource_type"`
+	Attribute    string `hclext:"attribute,optional"`
+	Prefix       string `hclext:"prefix,optional"`
+	Suffix       string `hclext:"suffix,optional"`
+	Format       string `hclext:"format,optional"`
+	Case         string `hclext:"case,optional"`
+
+	format *regexp.Regexp
+}
+
+// compile validates the convention and fills in defaults.
+func (c *NamingConvention) compile() error {
+	if c.Attribute == "" {
+		c.Attribute = "name"
+	}
+
+	if c.Case != "" {
+		if _, exists := namingCases[c.Case]; !exists {
+			return fmt.Errorf("unknown case %q in naming convention for %s", c.Case, c.ResourceType)
+		}
+	}
+
+	if c.Format != "" {
+		format, err := regexp.Compile(c.Format)
+		if err != nil {
+			return fmt.Errorf("invalid format in naming convention for %s: %w", c.ResourceType, err)
+		}
+		c.format = format
+	}
+
+	return nil
+}
+
+// violations returns a description of each way the value breaks the convention.
+func (c *NamingConvention) violations(value string) []string {
+	var violations []string
+
+	if !strings.HasPrefix(value, c.Prefix) {
+		violations = append(violations, fmt.Sprintf("must start with '%s'", c.Prefix))
+	}
+	if !strings.HasSuffix(value, c.Suffix) {
+		violations = append(violations, fmt.Sprintf("must end with '%s'", c.Suffix))
+	}
+	if c.format != nil && !c.format.MatchString(value) {
+		violations = append(violations, fmt.Sprintf("must match format %s", c.Format))
+	}
+	if c.Case != "" && !namingCases[c.Case].MatchString(value) {
+		violations = append(violations, fmt.Sprintf("must be %s case", c.Case))
+	}
+
+	return violations
+}
+
+// OktaNamingConventionRule checks the naming conventions declared in the plugin block.
+// It does nothing unless at least one naming_convention block is configured.
+type OktaNamingConventionRule struct {
+	tflint.DefaultRule
+	conventions []NamingConvention
+}
+
+func NewOktaNamingConventionRule() *OktaNamingConventionRule {
+	return &OktaNamingConventionRule{}
+}
+
+func (r *OktaNamingConventionRule) Name() string {
+	return "okta_naming_convention"
+}
+
+func (r *OktaNamingConventionRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaNamingConventionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// SetConventions validates and replaces the conventions the rule checks.
+func (r *OktaNamingConventionRule) SetConventions(conventions []NamingConvention) error {
+	for i := range conventions {
+		if err := conventions[i].compile(); err != nil {
+			return err
+		}
+	}
+	r.conventions = conventions
+	return nil
+}
+
+func (r *OktaNamingConventionRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	for _, convention := range r.conventions {
+		resources, err := runner.GetResourceContent(convention.ResourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: convention.Attribute}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attribute, exists := resource.Body.Attributes[convention.Attribute]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(value string) error {
+				for _, violation := range convention.violations(value) {
+					err = runner.EmitIssue(r, fmt.Sprintf("%s %s %s", convention.ResourceType, convention.Attribute, violation), attribute.Range)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
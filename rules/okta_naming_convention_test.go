@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaNamingConventionRule_Unconfigured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "No naming conventions are configured",
+			Content: `
+resource "okta_group" "example" {
+  name = "Example Group"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaNamingConventionRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaNamingConventionRule_Configured(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Names follow the naming conventions",
+			Content: `
+resource "okta_group" "example" {
+  name = "iac-example-prod"
+}
+
+resource "okta_app_oauth" "example" {
+  label = "IAC_EXAMPLE"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Names break the naming conventions",
+			Content: `
+resource "okta_group" "example" {
+  name = "Example"
+}
+
+resource "okta_app_oauth" "example" {
+  label = "iac_example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaNamingConventionRule(),
+					Message: "okta_group name must start with 'iac-'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+				{
+					Rule:    NewOktaNamingConventionRule(),
+					Message: "okta_group name must end with '-prod'",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+				{
+					Rule:    NewOktaNamingConventionRule(),
+					Message: "okta_group name must be kebab case",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+				{
+					Rule:    NewOktaNamingConventionRule(),
+					Message: "okta_app_oauth label must match format ^IAC_",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 24},
+					},
+				},
+				{
+					Rule:    NewOktaNamingConventionRule(),
+					Message: "okta_app_oauth label must be upper case",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaNamingConventionRule()
+	err := rule.SetConventions([]NamingConvention{
+		{ResourceType: "okta_group", Prefix: "iac-", Suffix: "-prod", Case: "kebab"},
+		{ResourceType: "okta_app_oauth", Attribute: "label", Format: "^IAC_", Case: "upper"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaNamingConventionRule_Invalid(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Convention NamingConvention
+	}{
+		{
+			Name:       "Unknown case",
+			Convention: NamingConvention{ResourceType: "okta_group", Case: "camel"},
+		},
+		{
+			Name:       "Invalid format",
+			Convention: NamingConvention{ResourceType: "okta_group", Format: "^[a-z"},
+		},
+	}
+
+	rule := NewOktaNamingConventionRule()
+
+	for _, tc := range cases {
+		if err := rule.SetConventions([]NamingConvention{tc.Convention}); err == nil {
+			t.Fatalf("Expected an error for %s", tc.Name)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// compareVersions compares dotted version numbers such as 14.2.1, returning -1, 0 or 1.
+// Missing components count as 0, so 14.2 equals 14.2.0.
+func compareVersions(a string, b string) (int, error) {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aNumber, err := versionComponent(aParts, i)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a version number", a)
+		}
+		bNumber, err := versionComponent(bParts, i)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a version number", b)
+		}
+		if aNumber != bNumber {
+			if aNumber < bNumber {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func versionComponent(parts []string, i int) (int, error) {
+	if i >= len(parts) {
+		return 0, nil
+	}
+	return strconv.Atoi(parts[i])
+}
+
+// deviceAssuranceBoolean is satisfied by any one of its attributes being set to its value.
+type deviceAssuranceBoolean struct {
+	attributes []string
+	value      bool
+}
+
+// OktaPolicyDeviceAssuranceRule checks that a device assurance policy sets the platform's minimum requirements.
+type OktaPolicyDeviceAssuranceRule struct {
+	tflint.DefaultRule
+	name             string
+	resourceType     string
+	versionAttribute string
+	minOSVersion     string
+	// includes maps a list attribute to a value it must include.
+	includes map[string]string
+	// required lists attributes which must be set to a non-empty list.
+	required []string
+	booleans []deviceAssuranceBoolean
+}
+
+// MinOSVersion is the lowest OS version the policy may allow.
+type oktaPolicyDeviceAssuranceRuleConfig struct {
+	MinOSVersion string `hclext:"min_os_version,optional"`
+}
+
+func NewOktaPolicyDeviceAssuranceMacOSRule() *OktaPolicyDeviceAssuranceRule {
+	return &OktaPolicyDeviceAssuranceRule{
+		name:             "okta_policy_device_assurance_macos",
+		resourceType:     "okta_policy_device_assurance_macos",
+		versionAttribute: "os_version",
+		minOSVersion:     "14.0",
+		includes:         map[string]string{"disk_encryption_type": "ALL_INTERNAL_VOLUMES"},
+		required:         []string{"screenlock_type"},
+	}
+}
+
+func NewOktaPolicyDeviceAssuranceWindowsRule() *OktaPolicyDeviceAssuranceRule {
+	return &OktaPolicyDeviceAssuranceRule{
+		name:             "okta_policy_device_assurance_windows",
+		resourceType:     "okta_policy_device_assurance_windows",
+		versionAttribute: "os_version",
+		minOSVersion:     "10.0.19045",
+		includes:         map[string]string{"disk_encryption_type": "ALL_INTERNAL_VOLUMES"},
+		booleans: []deviceAssuranceBoolean{
+			{attributes: []string{"secure_hardware_present", "tpsp_secure_boot_enabled"}, value: true},
+		},
+	}
+}
+
+func NewOktaPolicyDeviceAssuranceIOSRule() *OktaPolicyDeviceAssuranceRule {
+	return &OktaPolicyDeviceAssuranceRule{
+		name:             "okta_policy_device_assurance_ios",
+		resourceType:     "okta_policy_device_assurance_ios",
+		versionAttribute: "os_version",
+		minOSVersion:     "17.0",
+		required:         []string{"screenlock_type"},
+		booleans: []deviceAssuranceBoolean{
+			{attributes: []string{"jailbreak"}, value: false},
+		},
+	}
+}
+
+func NewOktaPolicyDeviceAssuranceAndroidRule() *OktaPolicyDeviceAssuranceRule {
+	return &OktaPolicyDeviceAssuranceRule{
+		name:             "okta_policy_device_assurance_android",
+		resourceType:     "okta_policy_device_assurance_android",
+		versionAttribute: "os_version",
+		minOSVersion:     "14",
+		required:         []string{"disk_encryption_type", "screenlock_type"},
+		booleans: []deviceAssuranceBoolean{
+			{attributes: []string{"jailbreak"}, value: false},
+		},
+	}
+}
+
+func (r *OktaPolicyDeviceAssuranceRule) Name() string {
+	return r.name
+}
+
+func (r *OktaPolicyDeviceAssuranceRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyDeviceAssuranceRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyDeviceAssuranceRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyDeviceAssuranceRuleConfig{MinOSVersion: r.minOSVersion}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if _, err := compareVersions(config.MinOSVersion, config.MinOSVersion); err != nil {
+		return fmt.Errorf("invalid min_os_version for %s rule: %w", r.Name(), err)
+	}
+
+	attributes := []hclext.AttributeSchema{{Name: r.versionAttribute}}
+	for _, name := range r.listAttributes() {
+		attributes = append(attributes, hclext.AttributeSchema{Name: name})
+	}
+	for _, boolean := range r.booleans {
+		for _, name := range boolean.attributes {
+			attributes = append(attributes, hclext.AttributeSchema{Name: name})
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := r.checkOSVersion(runner, resource, config.MinOSVersion); err != nil {
+			return err
+		}
+
+		for _, name := range r.listAttributes() {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				err := runner.EmitIssue(r, fmt.Sprintf("%s is not set, but %s", name, r.describeRequirement(name)), resource.DefRange)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(values []string) error {
+				value, include := r.includes[name]
+				if len(values) == 0 || include && !slices.Contains(values, value) {
+					return runner.EmitIssue(r, fmt.Sprintf("%s %s", name, r.describeRequirement(name)), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, boolean := range r.booleans {
+			if err := r.checkBoolean(runner, resource, boolean); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *OktaPolicyDeviceAssuranceRule) checkBoolean(runner tflint.Runner, resource *hclext.Block, boolean deviceAssuranceBoolean) error {
+	satisfied, known := false, true
+	issueRange := resource.DefRange
+	set := false
+	for _, name := range boolean.attributes {
+		attribute, exists := resource.Body.Attributes[name]
+		if !exists {
+			continue
+		}
+		if !set {
+			issueRange, set = attribute.Range, true
+		}
+		evaluated := false
+		err := runner.EvaluateExpr(attribute.Expr, func(value bool) error {
+			evaluated = true
+			satisfied = satisfied || value == boolean.value
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+		known = known && evaluated
+	}
+	if satisfied || !known {
+		return nil
+	}
+
+	switch {
+	case len(boolean.attributes) > 1:
+		return runner.EmitIssue(r, fmt.Sprintf("One of %s must be %t", strings.Join(boolean.attributes, " or "), boolean.value), issueRange)
+	case set:
+		return runner.EmitIssue(r, fmt.Sprintf("%s must be %t", boolean.attributes[0], boolean.value), issueRange)
+	default:
+		return runner.EmitIssue(r, fmt.Sprintf("%s is not set, but must be %t", boolean.attributes[0], boolean.value), issueRange)
+	}
+}
+
+func (r *OktaPolicyDeviceAssuranceRule) checkOSVersion(runner tflint.Runner, resource *hclext.Block, minOSVersion string) error {
+	attribute, exists := resource.Body.Attributes[r.versionAttribute]
+	if !exists {
+		return runner.EmitIssue(r, fmt.Sprintf("%s is not set, but must be at least %s", r.versionAttribute, minOSVersion), resource.DefRange)
+	}
+
+	return runner.EvaluateExpr(attribute.Expr, func(version string) error {
+		comparison, err := compareVersions(version, minOSVersion)
+		if err != nil {
+			return runner.EmitIssue(r, fmt.Sprintf("%s is invalid: %s", r.versionAttribute, err), attribute.Range)
+		}
+		if comparison < 0 {
+			return runner.EmitIssue(r, fmt.Sprintf("%s is %s, but must be at least %s", r.versionAttribute, version, minOSVersion), attribute.Range)
+		}
+		return nil
+	}, nil)
+}
+
+// listAttributes returns the list attributes the rule checks, in a stable order.
+func (r *OktaPolicyDeviceAssuranceRule) listAttributes() []string {
+	names := slices.Clone(r.required)
+	for name := range r.includes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (r *OktaPolicyDeviceAssuranceRule) describeRequirement(name string) string {
+	if value, exists := r.includes[name]; exists {
+		return "must include " + value
+	}
+	return "must not be empty"
+}
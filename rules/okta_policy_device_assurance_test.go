@@ -0,0 +1,428 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyDeviceAssuranceMacOSRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Minimum requirements set",
+			Content: `
+resource "okta_policy_device_assurance_macos" "example" {
+  os_version           = "14.2.1"
+  disk_encryption_type = ["ALL_INTERNAL_VOLUMES"]
+  screenlock_type      = ["BIOMETRIC", "PASSCODE"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Old OS version without encryption or screen lock",
+			Content: `
+resource "okta_policy_device_assurance_macos" "example" {
+  os_version           = "13.6"
+  disk_encryption_type = []
+  screenlock_type      = []
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceMacOSRule(),
+					Message: "os_version is 13.6, but must be at least 14.0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceMacOSRule(),
+					Message: "disk_encryption_type must include ALL_INTERNAL_VOLUMES",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceMacOSRule(),
+					Message: "screenlock_type must not be empty",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Nothing set",
+			Content: `
+resource "okta_policy_device_assurance_macos" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceMacOSRule(),
+					Message: "os_version is not set, but must be at least 14.0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 56},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceMacOSRule(),
+					Message: "disk_encryption_type is not set, but must include ALL_INTERNAL_VOLUMES",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 56},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceMacOSRule(),
+					Message: "screenlock_type is not set, but must not be empty",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 56},
+					},
+				},
+			},
+		},
+		{
+			Name: "Invalid OS version",
+			Content: `
+resource "okta_policy_device_assurance_macos" "example" {
+  os_version           = "Sonoma"
+  disk_encryption_type = ["ALL_INTERNAL_VOLUMES"]
+  screenlock_type      = ["PASSCODE"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceMacOSRule(),
+					Message: "os_version is invalid: \"Sonoma\" is not a version number",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured minimum OS version",
+			Content: `
+resource "okta_policy_device_assurance_macos" "example" {
+  os_version           = "14.10"
+  disk_encryption_type = ["ALL_INTERNAL_VOLUMES"]
+  screenlock_type      = ["PASSCODE"]
+}`,
+			Config: `
+rule "okta_policy_device_assurance_macos" {
+  enabled        = true
+  min_os_version = "14.9.1"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyDeviceAssuranceMacOSRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyDeviceAssuranceWindowsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Minimum requirements set",
+			Content: `
+resource "okta_policy_device_assurance_windows" "example" {
+  os_version              = "10.0.22631"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  secure_hardware_present = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Secure boot reported by a third-party signal provider",
+			Content: `
+resource "okta_policy_device_assurance_windows" "example" {
+  os_version               = "10.0.19045"
+  disk_encryption_type     = ["ALL_INTERNAL_VOLUMES"]
+  secure_hardware_present  = false
+  tpsp_secure_boot_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Old OS version without BitLocker or secure boot",
+			Content: `
+resource "okta_policy_device_assurance_windows" "example" {
+  os_version              = "10.0.17763"
+  disk_encryption_type    = []
+  secure_hardware_present = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceWindowsRule(),
+					Message: "os_version is 10.0.17763, but must be at least 10.0.19045",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceWindowsRule(),
+					Message: "disk_encryption_type must include ALL_INTERNAL_VOLUMES",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 31},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceWindowsRule(),
+					Message: "One of secure_hardware_present or tpsp_secure_boot_enabled must be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "Secure boot not set",
+			Content: `
+resource "okta_policy_device_assurance_windows" "example" {
+  os_version           = "10.0.22631"
+  disk_encryption_type = ["ALL_INTERNAL_VOLUMES"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceWindowsRule(),
+					Message: "One of secure_hardware_present or tpsp_secure_boot_enabled must be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 58},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyDeviceAssuranceWindowsRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyDeviceAssuranceIOSRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Minimum requirements set",
+			Content: `
+resource "okta_policy_device_assurance_ios" "example" {
+  os_version      = "17.4"
+  jailbreak       = false
+  screenlock_type = ["BIOMETRIC"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Jailbroken devices allowed",
+			Content: `
+resource "okta_policy_device_assurance_ios" "example" {
+  os_version      = "17.4"
+  jailbreak       = true
+  screenlock_type = ["BIOMETRIC"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceIOSRule(),
+					Message: "jailbreak must be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "Nothing set",
+			Content: `
+resource "okta_policy_device_assurance_ios" "example" {
+}`,
+			Config: `
+rule "okta_policy_device_assurance_ios" {
+  enabled        = true
+  min_os_version = "18"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceIOSRule(),
+					Message: "os_version is not set, but must be at least 18",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 54},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceIOSRule(),
+					Message: "screenlock_type is not set, but must not be empty",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 54},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceIOSRule(),
+					Message: "jailbreak is not set, but must be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 54},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyDeviceAssuranceIOSRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyDeviceAssuranceAndroidRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Minimum requirements set",
+			Content: `
+resource "okta_policy_device_assurance_android" "example" {
+  os_version           = "14"
+  disk_encryption_type = ["FULL", "USER"]
+  screenlock_type      = ["BIOMETRIC"]
+  jailbreak            = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Rooted devices allowed without encryption",
+			Content: `
+resource "okta_policy_device_assurance_android" "example" {
+  os_version           = "12"
+  disk_encryption_type = []
+  screenlock_type      = ["PASSCODE"]
+  jailbreak            = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceAndroidRule(),
+					Message: "os_version is 12, but must be at least 14",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceAndroidRule(),
+					Message: "disk_encryption_type must not be empty",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyDeviceAssuranceAndroidRule(),
+					Message: "jailbreak must be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured minimum OS version",
+			Content: `
+resource "okta_policy_device_assurance_android" "example" {
+  os_version           = "13"
+  disk_encryption_type = ["FULL"]
+  screenlock_type      = ["PASSCODE"]
+  jailbreak            = false
+}`,
+			Config: `
+rule "okta_policy_device_assurance_android" {
+  enabled        = true
+  min_os_version = "13"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyDeviceAssuranceAndroidRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyEveryoneGroupRule checks that privileged policies are not scoped to the Everyone group alone.
+// The Everyone group is recognised by references to okta_everyone_group data sources
+// and to okta_group data sources named Everyone.
+type OktaPolicyEveryoneGroupRule struct {
+	tflint.DefaultRule
+	resourceTypes   []string
+	groupsAttribute string
+	nameAttribute   string
+	everyoneTypes   []string
+	groupType       string
+	everyoneName    string
+	privileged      string
+}
+
+// Privileged is a regular expression matching the names of the policies to check.
+type oktaPolicyEveryoneGroupRuleConfig struct {
+	Privileged string `hclext:"privileged,optional"`
+}
+
+func NewOktaPolicyEveryoneGroupRule() *OktaPolicyEveryoneGroupRule {
+	return &OktaPolicyEveryoneGroupRule{
+		resourceTypes:   []string{"okta_policy_mfa", "okta_policy_signon", "okta_policy_password"},
+		groupsAttribute: "groups_included",
+		nameAttribute:   "name",
+		everyoneTypes:   []string{"okta_everyone_group"},
+		groupType:       "okta_group",
+		everyoneName:    "Everyone",
+		privileged:      "(?i)admin",
+	}
+}
+
+func (r *OktaPolicyEveryoneGroupRule) Name() string {
+	return "okta_policy_everyone_group"
+}
+
+func (r *OktaPolicyEveryoneGroupRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyEveryoneGroupRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaPolicyEveryoneGroupRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyEveryoneGroupRuleConfig{Privileged: r.privileged}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	privileged, err := regexp.Compile(config.Privileged)
+	if err != nil {
+		return fmt.Errorf("invalid privileged pattern for %s rule: %w", r.Name(), err)
+	}
+
+	everyone, err := r.everyoneGroups(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := getResourcesContent(runner, r.resourceTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.groupsAttribute}, {Name: r.nameAttribute}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		groupsAttribute, exists := resource.Body.Attributes[r.groupsAttribute]
+		if !exists {
+			continue
+		}
+		nameAttribute, exists := resource.Body.Attributes[r.nameAttribute]
+		if !exists {
+			continue
+		}
+
+		traversals := groupsAttribute.Expr.Variables()
+		if len(traversals) == 0 {
+			continue
+		}
+		onlyEveryone := true
+		for _, traversal := range traversals {
+			address, ok := dataSourceAddress(traversal)
+			onlyEveryone = onlyEveryone && ok && everyone[address]
+		}
+		if !onlyEveryone {
+			continue
+		}
+
+		err := runner.EvaluateExpr(nameAttribute.Expr, func(name string) error {
+			if !privileged.MatchString(name) {
+				return nil
+			}
+			return runner.EmitIssue(r, fmt.Sprintf("Privileged policy %s only includes the Everyone group, scope it to the groups it is meant for", name), groupsAttribute.Range)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// everyoneGroups returns the addresses of the data sources, such as data.okta_group.everyone,
+// which look up the Everyone group.
+func (r *OktaPolicyEveryoneGroupRule) everyoneGroups(runner tflint.Runner) (map[string]bool, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{
+			Type:       "data",
+			LabelNames: []string{"type", "name"},
+			Body:       &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: r.nameAttribute}}},
+		}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	everyone := map[string]bool{}
+	for _, data := range content.Blocks {
+		address := "data." + data.Labels[0] + "." + data.Labels[1]
+		if matchesResourceType(data.Labels[0], r.everyoneTypes) {
+			everyone[address] = true
+			continue
+		}
+		if data.Labels[0] != r.groupType {
+			continue
+		}
+		attribute, exists := data.Body.Attributes[r.nameAttribute]
+		if !exists {
+			continue
+		}
+		err := runner.EvaluateExpr(attribute.Expr, func(name string) error {
+			everyone[address] = name == r.everyoneName
+			return nil
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return everyone, nil
+}
+
+// dataSourceAddress returns the address of the data source a traversal refers to, if any.
+func dataSourceAddress(traversal hcl.Traversal) (string, bool) {
+	if len(traversal) < 3 || traversal.RootName() != "data" {
+		return "", false
+	}
+	dataType, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	name, ok := traversal[2].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	return "data." + dataType.Name + "." + name.Name, true
+}
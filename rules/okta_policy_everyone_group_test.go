@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyEveryoneGroupRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Privileged policy scoped to Everyone",
+			Content: `
+data "okta_group" "everyone" {
+  name = "Everyone"
+}
+
+resource "okta_policy_mfa" "admins" {
+  name            = "Admin MFA"
+  groups_included = [data.okta_group.everyone.id]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyEveryoneGroupRule(),
+					Message: "Privileged policy Admin MFA only includes the Everyone group, scope it to the groups it is meant for",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "Everyone group data source",
+			Content: `
+data "okta_everyone_group" "everyone" {}
+
+resource "okta_policy_signon" "admins" {
+  name            = "Admin Sign-On"
+  groups_included = [data.okta_everyone_group.everyone.id]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyEveryoneGroupRule(),
+					Message: "Privileged policy Admin Sign-On only includes the Everyone group, scope it to the groups it is meant for",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 59},
+					},
+				},
+			},
+		},
+		{
+			Name: "Privileged policy scoped to admin group",
+			Content: `
+data "okta_everyone_group" "everyone" {}
+
+resource "okta_policy_password" "admins" {
+  name            = "Admin Password"
+  groups_included = [data.okta_everyone_group.everyone.id, okta_group.admins.id]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unprivileged policy scoped to Everyone",
+			Content: `
+data "okta_everyone_group" "everyone" {}
+
+resource "okta_policy_password" "default" {
+  name            = "Default Password"
+  groups_included = [data.okta_everyone_group.everyone.id]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Other group data source",
+			Content: `
+data "okta_group" "contractors" {
+  name = "Contractors"
+}
+
+resource "okta_policy_mfa" "admins" {
+  name            = "Admin MFA"
+  groups_included = [data.okta_group.contractors.id]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Custom privileged pattern",
+			Content: `
+data "okta_everyone_group" "everyone" {}
+
+resource "okta_policy_mfa" "finance" {
+  name            = "Finance MFA"
+  groups_included = [data.okta_everyone_group.everyone.id]
+}`,
+			Config: `
+rule "okta_policy_everyone_group" {
+  enabled    = true
+  privileged = "^Finance"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyEveryoneGroupRule(),
+					Message: "Privileged policy Finance MFA only includes the Everyone group, scope it to the groups it is meant for",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 59},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyEveryoneGroupRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,217 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyLimitRule checks that a numeric setting of a policy or policy rule is within a limit.
+// Resources which omit the setting are checked against the provider's default.
+type OktaPolicyLimitRule struct {
+	tflint.DefaultRule
+	name          string
+	resourceType  string
+	attributeName string
+	defaultValue  int
+	limit         int
+	maximum       bool
+	// warnUnset reports policies which omit the setting or set it to 0 as warnings rather than errors.
+	warnUnset bool
+	// warnOmitted reports resources which omit the setting as warnings, even when the default is within the limit.
+	warnOmitted bool
+	// zeroUnlimited treats 0 as no limit, which exceeds any maximum.
+	zeroUnlimited bool
+	// configurableWarnOmitted lets warnOmitted be set with the rule's warn_omitted option.
+	configurableWarnOmitted bool
+}
+
+// Limit replaces the rule's minimum, or maximum for rules which enforce one.
+type oktaPolicyLimitRuleConfig struct {
+	Limit int `hclext:"limit,optional"`
+}
+
+// WarnOmitted replaces the rule's warnOmitted, for rules whose configurableWarnOmitted is set.
+type oktaPolicyLimitRuleWarnOmittedConfig struct {
+	Limit       int  `hclext:"limit,optional"`
+	WarnOmitted bool `hclext:"warn_omitted,optional"`
+}
+
+func NewOktaPolicyPasswordMinLengthRule() *OktaPolicyLimitRule {
+	return &OktaPolicyLimitRule{
+		name:          "okta_policy_password_min_length",
+		resourceType:  "okta_policy_password",
+		attributeName: "password_min_length",
+		defaultValue:  8,
+		limit:         12,
+	}
+}
+
+func NewOktaPolicyPasswordHistoryCountRule() *OktaPolicyLimitRule {
+	return &OktaPolicyLimitRule{
+		name:          "okta_policy_password_history_count",
+		resourceType:  "okta_policy_password",
+		attributeName: "password_history_count",
+		defaultValue:  4,
+		limit:         8,
+		warnUnset:     true,
+	}
+}
+
+func NewOktaPolicyPasswordMinAgeRule() *OktaPolicyLimitRule {
+	return &OktaPolicyLimitRule{
+		name:          "okta_policy_password_min_age",
+		resourceType:  "okta_policy_password",
+		attributeName: "password_min_age_minutes",
+		defaultValue:  0,
+		limit:         60,
+	}
+}
+
+func NewOktaPolicyPasswordRecoveryEmailTokenRule() *OktaPolicyLimitRule {
+	return &OktaPolicyLimitRule{
+		name:          "okta_policy_password_recovery_email_token",
+		resourceType:  "okta_policy_password",
+		attributeName: "recovery_email_token",
+		defaultValue:  60,
+		limit:         60,
+		maximum:       true,
+	}
+}
+
+func NewOktaPolicyRuleSignonSessionLifetimeRule() *OktaPolicyLimitRule {
+	return &OktaPolicyLimitRule{
+		name:          "okta_policy_rule_signon_session_lifetime",
+		resourceType:  "okta_policy_rule_signon",
+		attributeName: "session_lifetime",
+		defaultValue:  120,
+		limit:         720,
+		maximum:       true,
+		warnOmitted:   true,
+		zeroUnlimited: true,
+	}
+}
+
+func NewOktaPolicyRuleSignonSessionIdleRule() *OktaPolicyLimitRule {
+	return &OktaPolicyLimitRule{
+		name:          "okta_policy_rule_signon_session_idle",
+		resourceType:  "okta_policy_rule_signon",
+		attributeName: "session_idle",
+		defaultValue:  120,
+		limit:         120,
+		maximum:       true,
+	}
+}
+
+func NewOktaAuthServerPolicyRuleAccessTokenLifetimeRule() *OktaPolicyLimitRule {
+	return &OktaPolicyLimitRule{
+		name:          "okta_auth_server_policy_rule_access_token_lifetime",
+		resourceType:  "okta_auth_server_policy_rule",
+		attributeName: "access_token_lifetime_minutes",
+		defaultValue:  60,
+		limit:         60,
+		maximum:       true,
+
+		configurableWarnOmitted: true,
+	}
+}
+
+func (r *OktaPolicyLimitRule) Name() string {
+	return r.name
+}
+
+func (r *OktaPolicyLimitRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyLimitRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyLimitRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config, err := r.decodeConfig(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	var unset tflint.Rule = r
+	if r.warnUnset {
+		unset = &ruleWithSeverity{Rule: r, severity: tflint.WARNING}
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if r.violates(r.defaultValue, config.Limit) {
+				err = runner.EmitIssue(unset, fmt.Sprintf("%s defaults to %d, %s", r.attributeName, r.defaultValue, r.describeLimit(r.defaultValue, config.Limit)), resource.DefRange)
+				if err != nil {
+					return err
+				}
+			} else if config.WarnOmitted {
+				warning := &ruleWithSeverity{Rule: r, severity: tflint.WARNING}
+				err = runner.EmitIssue(warning, fmt.Sprintf("%s is not set and defaults to %d, set it explicitly", r.attributeName, r.defaultValue), resource.DefRange)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(value int) error {
+			if value == 0 && r.violates(value, config.Limit) {
+				return runner.EmitIssue(unset, fmt.Sprintf("%s is %d, %s", r.attributeName, value, r.describeLimit(value, config.Limit)), attribute.Range)
+			}
+			if r.violates(value, config.Limit) {
+				return runner.EmitIssue(r, fmt.Sprintf("%s is %d, %s", r.attributeName, value, r.describeLimit(value, config.Limit)), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeConfig decodes the rule's configuration, which only accepts warn_omitted if configurableWarnOmitted is set.
+func (r *OktaPolicyLimitRule) decodeConfig(runner tflint.Runner) (oktaPolicyLimitRuleWarnOmittedConfig, error) {
+	config := oktaPolicyLimitRuleWarnOmittedConfig{Limit: r.limit, WarnOmitted: r.warnOmitted}
+	if r.configurableWarnOmitted {
+		err := runner.DecodeRuleConfig(r.Name(), &config)
+		return config, err
+	}
+
+	limitConfig := oktaPolicyLimitRuleConfig{Limit: r.limit}
+	err := runner.DecodeRuleConfig(r.Name(), &limitConfig)
+	config.Limit = limitConfig.Limit
+	return config, err
+}
+
+func (r *OktaPolicyLimitRule) violates(value int, limit int) bool {
+	if r.maximum {
+		return value > limit || r.zeroUnlimited && value == 0
+	}
+	return value < limit
+}
+
+func (r *OktaPolicyLimitRule) describeLimit(value int, limit int) string {
+	if r.maximum && r.zeroUnlimited && value == 0 {
+		return fmt.Sprintf("which is unlimited and exceeds the maximum of %d", limit)
+	}
+	if r.maximum {
+		return fmt.Sprintf("which exceeds the maximum of %d", limit)
+	}
+	return fmt.Sprintf("which is below the minimum of %d", limit)
+}
@@ -0,0 +1,647 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaPolicyPasswordMinLengthRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Minimum length is long enough",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_length = 14
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Minimum length is too short",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_length = 8
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMinLengthRule(),
+					Message: "password_min_length is 8, which is below the minimum of 12",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "Minimum length is omitted",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMinLengthRule(),
+					Message: "password_min_length defaults to 8, which is below the minimum of 12",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured minimum length",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_length = 14
+}`,
+			Config: `
+rule "okta_policy_password_min_length" {
+  enabled = true
+  limit   = 16
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMinLengthRule(),
+					Message: "password_min_length is 14, which is below the minimum of 16",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordMinLengthRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyPasswordHistoryCountRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "History count is high enough",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_history_count = 10
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "History count is too low",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_history_count = 4
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordHistoryCountRule(),
+					Message: "password_history_count is 4, which is below the minimum of 8",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "History count is disabled",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_history_count = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyPasswordHistoryCountRule(), severity: tflint.WARNING},
+					Message: "password_history_count is 0, which is below the minimum of 8",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "History count is omitted",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyPasswordHistoryCountRule(), severity: tflint.WARNING},
+					Message: "password_history_count defaults to 4, which is below the minimum of 8",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured history count",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Config: `
+rule "okta_policy_password_history_count" {
+  enabled = true
+  limit   = 4
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordHistoryCountRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyPasswordMinAgeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Sufficient minimum age",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_age_minutes = 1440
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Short minimum age",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_age_minutes = 5
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMinAgeRule(),
+					Message: "password_min_age_minutes is 5, which is below the minimum of 60",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "Default minimum age",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMinAgeRule(),
+					Message: "password_min_age_minutes defaults to 0, which is below the minimum of 60",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured minimum age",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_age_minutes = 30
+}`,
+			Config: `
+rule "okta_policy_password_min_age" {
+  enabled = true
+  limit   = 30
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordMinAgeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyPasswordRecoveryEmailTokenRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Short token lifetime",
+			Content: `
+resource "okta_policy_password" "example" {
+  recovery_email_token = 15
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Default token lifetime",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Long token lifetime",
+			Content: `
+resource "okta_policy_password" "example" {
+  recovery_email_token = 10080
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordRecoveryEmailTokenRule(),
+					Message: "recovery_email_token is 10080, which exceeds the maximum of 60",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured token lifetime",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Config: `
+rule "okta_policy_password_recovery_email_token" {
+  enabled = true
+  limit   = 30
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordRecoveryEmailTokenRule(),
+					Message: "recovery_email_token defaults to 60, which exceeds the maximum of 30",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordRecoveryEmailTokenRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyRuleSignonSessionLifetimeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Session lifetime within the maximum",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_lifetime = 480
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Long session lifetime",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_lifetime = 1440
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonSessionLifetimeRule(),
+					Message: "session_lifetime is 1440, which exceeds the maximum of 720",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "Unlimited session lifetime",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_lifetime = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonSessionLifetimeRule(),
+					Message: "session_lifetime is 0, which is unlimited and exceeds the maximum of 720",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "Session lifetime omitted",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyRuleSignonSessionLifetimeRule(), severity: tflint.WARNING},
+					Message: "session_lifetime is not set and defaults to 120, set it explicitly",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "Session lifetime omitted with a lower maximum",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Config: `
+rule "okta_policy_rule_signon_session_lifetime" {
+  enabled = true
+  limit   = 60
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonSessionLifetimeRule(),
+					Message: "session_lifetime defaults to 120, which exceeds the maximum of 60",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyRuleSignonSessionLifetimeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyRuleSignonSessionIdleRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Session idle timeout within the maximum",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_idle = 30
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Default session idle timeout",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Long session idle timeout",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_idle = 480
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonSessionIdleRule(),
+					Message: "session_idle is 480, which exceeds the maximum of 120",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured session idle timeout",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Config: `
+rule "okta_policy_rule_signon_session_idle" {
+  enabled = true
+  limit   = 15
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonSessionIdleRule(),
+					Message: "session_idle defaults to 120, which exceeds the maximum of 15",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyRuleSignonSessionIdleRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaAuthServerPolicyRuleAccessTokenLifetimeRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Access token lifetime within the maximum",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  access_token_lifetime_minutes = 15
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Default access token lifetime",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Long access token lifetime",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+  access_token_lifetime_minutes = 1440
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleAccessTokenLifetimeRule(),
+					Message: "access_token_lifetime_minutes is 1440, which exceeds the maximum of 60",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "Access token lifetime omitted with a lower maximum",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+}`,
+			Config: `
+rule "okta_auth_server_policy_rule_access_token_lifetime" {
+  enabled = true
+  limit   = 30
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaAuthServerPolicyRuleAccessTokenLifetimeRule(),
+					Message: "access_token_lifetime_minutes defaults to 60, which exceeds the maximum of 30",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "Access token lifetime omitted with warnings enabled",
+			Content: `
+resource "okta_auth_server_policy_rule" "example" {
+}`,
+			Config: `
+rule "okta_auth_server_policy_rule_access_token_lifetime" {
+  enabled      = true
+  warn_omitted = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaAuthServerPolicyRuleAccessTokenLifetimeRule(), severity: tflint.WARNING},
+					Message: "access_token_lifetime_minutes is not set and defaults to 60, set it explicitly",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaAuthServerPolicyRuleAccessTokenLifetimeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyLimitRule_WarnOmittedNotConfigurable(t *testing.T) {
+	rule := NewOktaPolicyRuleSignonSessionIdleRule()
+
+	runner := helper.TestRunner(t, map[string]string{
+		"resource.tf": `
+resource "okta_policy_rule_signon" "example" {
+}`,
+		".tflint.hcl": `
+rule "okta_policy_rule_signon_session_idle" {
+  enabled      = true
+  warn_omitted = true
+}`,
+	})
+
+	if err := rule.Check(runner); err == nil {
+		t.Fatal("Expected an error for the unsupported warn_omitted option")
+	}
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyMfaFactorRule checks that MFA policies do not allow enrollment in weak factors.
+// A factor is allowed unless its enroll setting is NOT_ALLOWED.
+type OktaPolicyMfaFactorRule struct {
+	tflint.DefaultRule
+	name          string
+	resourceType  string
+	nameAttribute string
+	factors       []string
+	severity      tflint.Severity
+	reason        string
+}
+
+// Exempt lists the names of policies which may allow the factors, such as legacy policies.
+// Severity overrides the rule's severity.
+type oktaPolicyMfaFactorRuleConfig struct {
+	Exempt   []string `hclext:"exempt,optional"`
+	Severity string   `hclext:"severity,optional"`
+}
+
+func NewOktaPolicyMfaPhoneFactorRule() *OktaPolicyMfaFactorRule {
+	return &OktaPolicyMfaFactorRule{
+		name:          "okta_policy_mfa_phone_factor",
+		resourceType:  "okta_policy_mfa",
+		nameAttribute: "name",
+		factors:       []string{"okta_sms", "okta_call"},
+		severity:      tflint.ERROR,
+		reason:        "phone factors are vulnerable to SIM swapping",
+	}
+}
+
+func NewOktaPolicyMfaEmailFactorRule() *OktaPolicyMfaFactorRule {
+	return &OktaPolicyMfaFactorRule{
+		name:          "okta_policy_mfa_email_factor",
+		resourceType:  "okta_policy_mfa",
+		nameAttribute: "name",
+		factors:       []string{"okta_email"},
+		severity:      tflint.WARNING,
+		reason:        "email is the weakest factor",
+	}
+}
+
+func NewOktaPolicyMfaQuestionFactorRule() *OktaPolicyMfaFactorRule {
+	return &OktaPolicyMfaFactorRule{
+		name:          "okta_policy_mfa_question_factor",
+		resourceType:  "okta_policy_mfa",
+		nameAttribute: "name",
+		factors:       []string{"okta_question"},
+		severity:      tflint.ERROR,
+		reason:        "knowledge-based factors are not allowed",
+	}
+}
+
+func (r *OktaPolicyMfaFactorRule) Name() string {
+	return r.name
+}
+
+func (r *OktaPolicyMfaFactorRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyMfaFactorRule) Severity() tflint.Severity {
+	return r.severity
+}
+
+func (r *OktaPolicyMfaFactorRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyMfaFactorRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	rule, err := withSeverity(r, config.Severity)
+	if err != nil {
+		return err
+	}
+
+	attributes := []hclext.AttributeSchema{{Name: r.nameAttribute}}
+	for _, factor := range r.factors {
+		attributes = append(attributes, hclext.AttributeSchema{Name: factor})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		exempt := false
+		if nameAttribute, exists := resource.Body.Attributes[r.nameAttribute]; exists && len(config.Exempt) > 0 {
+			err := runner.EvaluateExpr(nameAttribute.Expr, func(name string) error {
+				exempt = slices.Contains(config.Exempt, name)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if exempt {
+			continue
+		}
+
+		for _, factor := range r.factors {
+			attribute, exists := resource.Body.Attributes[factor]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(settings map[string]string) error {
+				if settings["enroll"] != "NOT_ALLOWED" {
+					return runner.EmitIssue(rule, fmt.Sprintf("MFA policy must not allow enrollment in %s, %s", factor, r.reason), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
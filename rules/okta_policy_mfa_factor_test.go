@@ -0,0 +1,227 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaPolicyMfaPhoneFactorRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Phone factors not allowed",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_sms = {
+    enroll = "NOT_ALLOWED"
+  }
+  okta_call = {
+    enroll = "NOT_ALLOWED"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Phone factors omitted",
+			Content: `
+resource "okta_policy_mfa" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Phone factors allowed",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_sms = {
+    enroll = "OPTIONAL"
+  }
+  okta_call = {
+    enroll = "REQUIRED"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyMfaPhoneFactorRule(),
+					Message: "MFA policy must not allow enrollment in okta_sms, phone factors are vulnerable to SIM swapping",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 4},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyMfaPhoneFactorRule(),
+					Message: "MFA policy must not allow enrollment in okta_call, phone factors are vulnerable to SIM swapping",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Exempt legacy policy",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  name = "Legacy contractors"
+  okta_sms = {
+    enroll = "OPTIONAL"
+  }
+}`,
+			Config: `
+rule "okta_policy_mfa_phone_factor" {
+  enabled = true
+  exempt  = ["Legacy contractors"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyMfaPhoneFactorRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyMfaEmailFactorRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Email factor not allowed",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_email = {
+    enroll = "NOT_ALLOWED"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Email factor allowed",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_email = {
+    enroll = "OPTIONAL"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyMfaEmailFactorRule(),
+					Message: "MFA policy must not allow enrollment in okta_email, email is the weakest factor",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Email factor allowed as an error",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_email = {
+    enroll = "OPTIONAL"
+  }
+}`,
+			Config: `
+rule "okta_policy_mfa_email_factor" {
+  enabled  = true
+  severity = "error"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyMfaEmailFactorRule(), severity: tflint.ERROR},
+					Message: "MFA policy must not allow enrollment in okta_email, email is the weakest factor",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 4},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyMfaEmailFactorRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyMfaQuestionFactorRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Security question factor not allowed",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_question = {
+    enroll = "NOT_ALLOWED"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Security question factor allowed",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_question = {
+    enroll = "OPTIONAL"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyMfaQuestionFactorRule(),
+					Message: "MFA policy must not allow enrollment in okta_question, knowledge-based factors are not allowed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 4},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyMfaQuestionFactorRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyMfaOktaVerifyRule checks that MFA policies require Okta Verify, or in Classic orgs,
+// Okta Verify OTP or push.
+type OktaPolicyMfaOktaVerifyRule struct {
+	tflint.DefaultRule
+	resourceType string
+	factors      []string
+}
+
+// AcceptOptional accepts policies which make Okta Verify optional rather than required.
+type oktaPolicyMfaOktaVerifyRuleConfig struct {
+	AcceptOptional bool `hclext:"accept_optional,optional"`
+}
+
+func NewOktaPolicyMfaOktaVerifyRule() *OktaPolicyMfaOktaVerifyRule {
+	return &OktaPolicyMfaOktaVerifyRule{
+		resourceType: "okta_policy_mfa",
+		factors:      []string{"okta_verify", "okta_otp", "okta_push"},
+	}
+}
+
+func (r *OktaPolicyMfaOktaVerifyRule) Name() string {
+	return "okta_policy_mfa_okta_verify"
+}
+
+func (r *OktaPolicyMfaOktaVerifyRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyMfaOktaVerifyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyMfaOktaVerifyRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyMfaOktaVerifyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	attributes := []hclext.AttributeSchema{}
+	for _, factor := range r.factors {
+		attributes = append(attributes, hclext.AttributeSchema{Name: factor})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		required, known := false, true
+		issueRange := resource.DefRange
+		for _, factor := range r.factors {
+			attribute, exists := resource.Body.Attributes[factor]
+			if !exists {
+				continue
+			}
+			if issueRange == resource.DefRange {
+				issueRange = attribute.Range
+			}
+
+			evaluated := false
+			err := runner.EvaluateExpr(attribute.Expr, func(settings map[string]string) error {
+				evaluated = true
+				required = required || settings["enroll"] == "REQUIRED" || config.AcceptOptional && settings["enroll"] == "OPTIONAL"
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+			known = known && evaluated
+		}
+		if required || !known {
+			continue
+		}
+
+		message := "MFA policy must require Okta Verify, set okta_verify enroll to REQUIRED"
+		if config.AcceptOptional {
+			message = "MFA policy must allow Okta Verify, set okta_verify enroll to REQUIRED or OPTIONAL"
+		}
+		if err := runner.EmitIssue(r, message, issueRange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyMfaOktaVerifyRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Okta Verify required",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_verify = {
+    enroll = "REQUIRED"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Classic Okta Verify push required",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_otp = {
+    enroll = "OPTIONAL"
+  }
+  okta_push = {
+    enroll = "REQUIRED"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Okta Verify optional",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_verify = {
+    enroll = "OPTIONAL"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyMfaOktaVerifyRule(),
+					Message: "MFA policy must require Okta Verify, set okta_verify enroll to REQUIRED",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Okta Verify omitted",
+			Content: `
+resource "okta_policy_mfa" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyMfaOktaVerifyRule(),
+					Message: "MFA policy must require Okta Verify, set okta_verify enroll to REQUIRED",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "Okta Verify optional accepted",
+			Content: `
+resource "okta_policy_mfa" "example" {
+  okta_verify = {
+    enroll = "OPTIONAL"
+  }
+}`,
+			Config: `
+rule "okta_policy_mfa_okta_verify" {
+  enabled         = true
+  accept_optional = true
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyMfaOktaVerifyRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
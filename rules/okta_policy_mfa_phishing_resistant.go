@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyMfaPhishingResistantRule checks that MFA policies including admin groups require
+// a phishing-resistant factor. Groups are recognised by references in groups_included.
+type OktaPolicyMfaPhishingResistantRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	groupType       string
+	groupsAttribute string
+	factors         []string
+	groups          string
+}
+
+// Groups is a regular expression matching the names of admin groups.
+// GroupResources lists the addresses of admin groups, such as okta_group.admins.
+type oktaPolicyMfaPhishingResistantRuleConfig struct {
+	Groups         string   `hclext:"groups,optional"`
+	GroupResources []string `hclext:"group_resources,optional"`
+}
+
+func NewOktaPolicyMfaPhishingResistantRule() *OktaPolicyMfaPhishingResistantRule {
+	return &OktaPolicyMfaPhishingResistantRule{
+		resourceType:    "okta_policy_mfa",
+		groupType:       "okta_group",
+		groupsAttribute: "groups_included",
+		factors:         []string{"fido2_webauthn", "webauthn"},
+		groups:          "(?i)admin",
+	}
+}
+
+func (r *OktaPolicyMfaPhishingResistantRule) Name() string {
+	return "okta_policy_mfa_phishing_resistant"
+}
+
+func (r *OktaPolicyMfaPhishingResistantRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyMfaPhishingResistantRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyMfaPhishingResistantRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyMfaPhishingResistantRuleConfig{Groups: r.groups}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var groups *regexp.Regexp
+	if config.Groups != "" {
+		var err error
+		groups, err = regexp.Compile(config.Groups)
+		if err != nil {
+			return fmt.Errorf("invalid groups pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	groupNames, err := resourceNames(runner, r.groupType, "name")
+	if err != nil {
+		return err
+	}
+
+	attributes := []hclext.AttributeSchema{{Name: r.groupsAttribute}}
+	for _, factor := range r.factors {
+		attributes = append(attributes, hclext.AttributeSchema{Name: factor})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		groupsAttribute, exists := resource.Body.Attributes[r.groupsAttribute]
+		if !exists {
+			continue
+		}
+
+		admin := ""
+		for _, traversal := range groupsAttribute.Expr.Variables() {
+			address, ok := resourceAddress(traversal)
+			if !ok {
+				continue
+			}
+			name, named := groupNames[address]
+			if slices.Contains(config.GroupResources, address) || named && groups != nil && groups.MatchString(name) {
+				admin = address
+				break
+			}
+		}
+		if admin == "" {
+			continue
+		}
+
+		required, known := false, true
+		for _, factor := range r.factors {
+			attribute, exists := resource.Body.Attributes[factor]
+			if !exists {
+				continue
+			}
+			evaluated := false
+			err := runner.EvaluateExpr(attribute.Expr, func(settings map[string]string) error {
+				evaluated = true
+				required = required || settings["enroll"] == "REQUIRED"
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+			known = known && evaluated
+		}
+		if required || !known {
+			continue
+		}
+
+		err := runner.EmitIssue(r, fmt.Sprintf("MFA policy including admin group %s must require a phishing-resistant factor, set fido2_webauthn or webauthn enroll to REQUIRED", admin), groupsAttribute.Range)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyMfaPhishingResistantRule(t *testing.T) {
+	groups := `
+resource "okta_group" "admins" {
+  name = "Super Admins"
+}
+
+resource "okta_group" "engineering" {
+  name = "Engineering"
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Admin policy requires WebAuthn",
+			Content: groups + `
+resource "okta_policy_mfa" "admins" {
+  groups_included = [okta_group.admins.id]
+  fido2_webauthn = {
+    enroll = "REQUIRED"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Other policy does not require WebAuthn",
+			Content: groups + `
+resource "okta_policy_mfa" "engineering" {
+  groups_included = [okta_group.engineering.id]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Admin policy does not require WebAuthn",
+			Content: groups + `
+resource "okta_policy_mfa" "admins" {
+  groups_included = [okta_group.engineering.id, okta_group.admins.id]
+  webauthn = {
+    enroll = "OPTIONAL"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyMfaPhishingResistantRule(),
+					Message: "MFA policy including admin group okta_group.admins must require a phishing-resistant factor, set fido2_webauthn or webauthn enroll to REQUIRED",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 3},
+						End:      hcl.Pos{Line: 11, Column: 70},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured admin group resource",
+			Content: groups + `
+resource "okta_policy_mfa" "engineering" {
+  groups_included = [okta_group.engineering.id]
+}`,
+			Config: `
+rule "okta_policy_mfa_phishing_resistant" {
+  enabled         = true
+  group_resources = ["okta_group.engineering"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyMfaPhishingResistantRule(),
+					Message: "MFA policy including admin group okta_group.engineering must require a phishing-resistant factor, set fido2_webauthn or webauthn enroll to REQUIRED",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 3},
+						End:      hcl.Pos{Line: 11, Column: 48},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyMfaPhishingResistantRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// passwordCharacterClass is a class of characters a password policy can require.
+type passwordCharacterClass struct {
+	name          string
+	attributeName string
+	defaultValue  int
+}
+
+var passwordCharacterClasses = []passwordCharacterClass{
+	{name: "lowercase", attributeName: "password_min_lowercase", defaultValue: 1},
+	{name: "uppercase", attributeName: "password_min_uppercase", defaultValue: 1},
+	{name: "number", attributeName: "password_min_number", defaultValue: 1},
+	{name: "symbol", attributeName: "password_min_symbol", defaultValue: 0},
+}
+
+type OktaPolicyPasswordComplexityRule struct {
+	tflint.DefaultRule
+	resourceType string
+}
+
+// Each attribute is the number of characters of its class required, or 0 to not require the class.
+type oktaPolicyPasswordComplexityRuleConfig struct {
+	Lowercase int `hclext:"lowercase,optional"`
+	Uppercase int `hclext:"uppercase,optional"`
+	Number    int `hclext:"number,optional"`
+	Symbol    int `hclext:"symbol,optional"`
+}
+
+func (c *oktaPolicyPasswordComplexityRuleConfig) minimum(class passwordCharacterClass) int {
+	switch class.name {
+	case "lowercase":
+		return c.Lowercase
+	case "uppercase":
+		return c.Uppercase
+	case "number":
+		return c.Number
+	default:
+		return c.Symbol
+	}
+}
+
+func NewOktaPolicyPasswordComplexityRule() *OktaPolicyPasswordComplexityRule {
+	return &OktaPolicyPasswordComplexityRule{
+		resourceType: "okta_policy_password",
+	}
+}
+
+func (r *OktaPolicyPasswordComplexityRule) Name() string {
+	return "okta_policy_password_complexity"
+}
+
+func (r *OktaPolicyPasswordComplexityRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyPasswordComplexityRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyPasswordComplexityRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyPasswordComplexityRuleConfig{Lowercase: 1, Uppercase: 1, Number: 1, Symbol: 1}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	attributes := []hclext.AttributeSchema{}
+	for _, class := range passwordCharacterClasses {
+		attributes = append(attributes, hclext.AttributeSchema{Name: class.attributeName})
+	}
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, class := range passwordCharacterClasses {
+			minimum := config.minimum(class)
+
+			attribute, exists := resource.Body.Attributes[class.attributeName]
+			if !exists {
+				if class.defaultValue < minimum {
+					err = runner.EmitIssue(r, fmt.Sprintf("%s defaults to %d, but must be at least %d to require %s characters", class.attributeName, class.defaultValue, minimum, class.name), resource.DefRange)
+					if err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(value int) error {
+				if value < minimum {
+					return runner.EmitIssue(r, fmt.Sprintf("%s is %d, but must be at least %d to require %s characters", class.attributeName, value, minimum, class.name), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
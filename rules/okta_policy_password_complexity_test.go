@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyPasswordComplexityRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Every class is required",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_lowercase = 1
+  password_min_uppercase = 1
+  password_min_number    = 1
+  password_min_symbol    = 1
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Symbols default to not being required",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordComplexityRule(),
+					Message: "password_min_symbol defaults to 0, but must be at least 1 to require symbol characters",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Classes are not required",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_lowercase = 0
+  password_min_uppercase = 0
+  password_min_number    = 1
+  password_min_symbol    = 1
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordComplexityRule(),
+					Message: "password_min_lowercase is 0, but must be at least 1 to require lowercase characters",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyPasswordComplexityRule(),
+					Message: "password_min_uppercase is 0, but must be at least 1 to require uppercase characters",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured classes",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_min_number = 1
+  password_min_symbol = 0
+}`,
+			Config: `
+rule "okta_policy_password_complexity" {
+  enabled = true
+  number  = 2
+  symbol  = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordComplexityRule(),
+					Message: "password_min_number is 1, but must be at least 2 to require number characters",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordComplexityRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaPolicyPasswordDictionaryLookupRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// Severity overrides the rule's severity, for organizations which screen passwords elsewhere.
+type oktaPolicyPasswordDictionaryLookupRuleConfig struct {
+	Severity string `hclext:"severity,optional"`
+}
+
+func NewOktaPolicyPasswordDictionaryLookupRule() *OktaPolicyPasswordDictionaryLookupRule {
+	return &OktaPolicyPasswordDictionaryLookupRule{
+		resourceType:  "okta_policy_password",
+		attributeName: "password_dictionary_lookup",
+	}
+}
+
+func (r *OktaPolicyPasswordDictionaryLookupRule) Name() string {
+	return "okta_policy_password_dictionary_lookup"
+}
+
+func (r *OktaPolicyPasswordDictionaryLookupRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyPasswordDictionaryLookupRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyPasswordDictionaryLookupRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyPasswordDictionaryLookupRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	rule, err := withSeverity(r, config.Severity)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			err = runner.EmitIssue(rule, fmt.Sprintf("%s defaults to false, so common passwords are allowed", r.attributeName), resource.DefRange)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(lookup bool) error {
+			if !lookup {
+				return runner.EmitIssue(rule, fmt.Sprintf("%s is false, so common passwords are allowed", r.attributeName), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaPolicyPasswordDictionaryLookupRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Dictionary lookup enabled",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_dictionary_lookup = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Dictionary lookup disabled",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_dictionary_lookup = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordDictionaryLookupRule(),
+					Message: "password_dictionary_lookup is false, so common passwords are allowed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "Dictionary lookup omitted",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordDictionaryLookupRule(),
+					Message: "password_dictionary_lookup defaults to false, so common passwords are allowed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured severity",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Config: `
+rule "okta_policy_password_dictionary_lookup" {
+  enabled  = true
+  severity = "notice"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyPasswordDictionaryLookupRule(), severity: tflint.NOTICE},
+					Message: "password_dictionary_lookup defaults to false, so common passwords are allowed",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordDictionaryLookupRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// passwordExcludeAttributes maps each setting which stops passwords containing part of the user's profile to its provider default.
+var passwordExcludeAttributes = []struct {
+	name         string
+	defaultValue bool
+}{
+	{name: "password_exclude_username", defaultValue: true},
+	{name: "password_exclude_first_name", defaultValue: false},
+	{name: "password_exclude_last_name", defaultValue: false},
+}
+
+type OktaPolicyPasswordExcludeNamesRule struct {
+	tflint.DefaultRule
+	resourceType string
+}
+
+func NewOktaPolicyPasswordExcludeNamesRule() *OktaPolicyPasswordExcludeNamesRule {
+	return &OktaPolicyPasswordExcludeNamesRule{
+		resourceType: "okta_policy_password",
+	}
+}
+
+func (r *OktaPolicyPasswordExcludeNamesRule) Name() string {
+	return "okta_policy_password_exclude_names"
+}
+
+func (r *OktaPolicyPasswordExcludeNamesRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyPasswordExcludeNamesRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyPasswordExcludeNamesRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	attributes := []hclext.AttributeSchema{}
+	for _, attribute := range passwordExcludeAttributes {
+		attributes = append(attributes, hclext.AttributeSchema{Name: attribute.name})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, setting := range passwordExcludeAttributes {
+			attribute, exists := resource.Body.Attributes[setting.name]
+			if !exists {
+				if !setting.defaultValue {
+					err := runner.EmitIssue(r, fmt.Sprintf("%s defaults to false, but must be true", setting.name), resource.DefRange)
+					if err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(exclude bool) error {
+				if !exclude {
+					return runner.EmitIssue(r, fmt.Sprintf("%s must be true", setting.name), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
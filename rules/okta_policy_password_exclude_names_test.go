@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyPasswordExcludeNamesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "All names excluded",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_exclude_username   = true
+  password_exclude_first_name = true
+  password_exclude_last_name  = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Names allowed",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_exclude_username   = false
+  password_exclude_first_name = false
+  password_exclude_last_name  = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordExcludeNamesRule(),
+					Message: "password_exclude_username must be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 38},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyPasswordExcludeNamesRule(),
+					Message: "password_exclude_first_name must be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "Defaults",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordExcludeNamesRule(),
+					Message: "password_exclude_first_name defaults to false, but must be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyPasswordExcludeNamesRule(),
+					Message: "password_exclude_last_name defaults to false, but must be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordExcludeNamesRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
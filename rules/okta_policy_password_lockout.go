@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyPasswordLockoutRule checks that password policies lock accounts after a number of failed attempts,
+// and warns when users are not notified that their account has been locked.
+type OktaPolicyPasswordLockoutRule struct {
+	tflint.DefaultRule
+	resourceType      string
+	attemptsAttribute string
+	channelsAttribute string
+	defaultAttempts   int
+	maxAttempts       int
+}
+
+// MaxAttempts is the largest number of failed attempts allowed before an account is locked.
+type oktaPolicyPasswordLockoutRuleConfig struct {
+	MaxAttempts int `hclext:"max_attempts,optional"`
+}
+
+func NewOktaPolicyPasswordLockoutRule() *OktaPolicyPasswordLockoutRule {
+	return &OktaPolicyPasswordLockoutRule{
+		resourceType:      "okta_policy_password",
+		attemptsAttribute: "password_max_lockout_attempts",
+		channelsAttribute: "password_lockout_notification_channels",
+		defaultAttempts:   10,
+		maxAttempts:       10,
+	}
+}
+
+func (r *OktaPolicyPasswordLockoutRule) Name() string {
+	return "okta_policy_password_lockout"
+}
+
+func (r *OktaPolicyPasswordLockoutRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyPasswordLockoutRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyPasswordLockoutRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyPasswordLockoutRuleConfig{MaxAttempts: r.maxAttempts}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attemptsAttribute}, {Name: r.channelsAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	warning := &ruleWithSeverity{Rule: r, severity: tflint.WARNING}
+
+	for _, resource := range resources.Blocks {
+		if attribute, exists := resource.Body.Attributes[r.attemptsAttribute]; exists {
+			err := runner.EvaluateExpr(attribute.Expr, func(attempts int) error {
+				if attempts == 0 {
+					return runner.EmitIssue(r, fmt.Sprintf("%s is 0, which disables account lockout", r.attemptsAttribute), attribute.Range)
+				}
+				if attempts > config.MaxAttempts {
+					return runner.EmitIssue(r, fmt.Sprintf("%s is %d, which exceeds the maximum of %d", r.attemptsAttribute, attempts, config.MaxAttempts), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		} else if r.defaultAttempts > config.MaxAttempts {
+			err := runner.EmitIssue(r, fmt.Sprintf("%s defaults to %d, which exceeds the maximum of %d", r.attemptsAttribute, r.defaultAttempts, config.MaxAttempts), resource.DefRange)
+			if err != nil {
+				return err
+			}
+		}
+
+		attribute, exists := resource.Body.Attributes[r.channelsAttribute]
+		if !exists {
+			err := runner.EmitIssue(warning, fmt.Sprintf("%s is not set, so users are not notified when their account is locked", r.channelsAttribute), resource.DefRange)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(channels []string) error {
+			if len(channels) == 0 {
+				return runner.EmitIssue(warning, fmt.Sprintf("%s is empty, so users are not notified when their account is locked", r.channelsAttribute), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaPolicyPasswordLockoutRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Lockout with notifications",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_lockout_attempts          = 5
+  password_lockout_notification_channels = ["EMAIL"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Lockout disabled",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_lockout_attempts          = 0
+  password_lockout_notification_channels = ["EMAIL"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordLockoutRule(),
+					Message: "password_max_lockout_attempts is 0, which disables account lockout",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "Too many attempts",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_lockout_attempts          = 20
+  password_lockout_notification_channels = ["EMAIL"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordLockoutRule(),
+					Message: "password_max_lockout_attempts is 20, which exceeds the maximum of 10",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "Empty notification channels",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_lockout_attempts          = 5
+  password_lockout_notification_channels = []
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyPasswordLockoutRule(), severity: tflint.WARNING},
+					Message: "password_lockout_notification_channels is empty, so users are not notified when their account is locked",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "Defaults",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Config: `
+rule "okta_policy_password_lockout" {
+  enabled      = true
+  max_attempts = 5
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordLockoutRule(),
+					Message: "password_max_lockout_attempts defaults to 10, which exceeds the maximum of 5",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyPasswordLockoutRule(), severity: tflint.WARNING},
+					Message: "password_lockout_notification_channels is not set, so users are not notified when their account is locked",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordLockoutRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+const (
+	// passwordMaxAgeRotation requires passwords to expire within a number of days.
+	passwordMaxAgeRotation = "rotation"
+	// passwordMaxAgeNIST requires passwords to never expire, following NIST SP 800-63B.
+	passwordMaxAgeNIST = "nist"
+)
+
+type OktaPolicyPasswordMaxAgeRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+	defaultValue  int
+	mode          string
+	maxDays       int
+}
+
+// Mode is either "rotation" or "nist". MaxDays is the longest passwords may be used for in rotation mode.
+type oktaPolicyPasswordMaxAgeRuleConfig struct {
+	Mode    string `hclext:"mode,optional"`
+	MaxDays int    `hclext:"max_days,optional"`
+}
+
+func NewOktaPolicyPasswordMaxAgeRule() *OktaPolicyPasswordMaxAgeRule {
+	return &OktaPolicyPasswordMaxAgeRule{
+		resourceType:  "okta_policy_password",
+		attributeName: "password_max_age_days",
+		defaultValue:  0,
+		mode:          passwordMaxAgeRotation,
+		maxDays:       90,
+	}
+}
+
+func (r *OktaPolicyPasswordMaxAgeRule) Name() string {
+	return "okta_policy_password_max_age"
+}
+
+func (r *OktaPolicyPasswordMaxAgeRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaPolicyPasswordMaxAgeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyPasswordMaxAgeRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyPasswordMaxAgeRuleConfig{Mode: r.mode, MaxDays: r.maxDays}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if config.Mode != passwordMaxAgeRotation && config.Mode != passwordMaxAgeNIST {
+		return fmt.Errorf("invalid mode %q for %s rule: must be %s or %s", config.Mode, r.Name(), passwordMaxAgeRotation, passwordMaxAgeNIST)
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if message := r.problem(config, r.defaultValue); message != "" {
+				err = runner.EmitIssue(r, fmt.Sprintf("%s defaults to %d, but %s", r.attributeName, r.defaultValue, message), resource.DefRange)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(days int) error {
+			if message := r.problem(config, days); message != "" {
+				return runner.EmitIssue(r, fmt.Sprintf("%s is %d, but %s", r.attributeName, days, message), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// problem describes what the maximum age should be in the configured mode, or returns an empty string if it is acceptable.
+func (r *OktaPolicyPasswordMaxAgeRule) problem(config oktaPolicyPasswordMaxAgeRuleConfig, days int) string {
+	if config.Mode == passwordMaxAgeNIST {
+		if days != 0 {
+			return "passwords should not expire"
+		}
+		return ""
+	}
+
+	if days == 0 || days > config.MaxDays {
+		return fmt.Sprintf("passwords must expire within %d days", config.MaxDays)
+	}
+	return ""
+}
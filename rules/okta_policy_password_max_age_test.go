@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyPasswordMaxAgeRule(t *testing.T) {
+	nist := `
+rule "okta_policy_password_max_age" {
+  enabled = true
+  mode    = "nist"
+}`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Passwords expire within the limit",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_age_days = 60
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Passwords expire too late",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_age_days = 365
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMaxAgeRule(),
+					Message: "password_max_age_days is 365, but passwords must expire within 90 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Passwords never expire",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMaxAgeRule(),
+					Message: "password_max_age_days defaults to 0, but passwords must expire within 90 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Passwords never expire in NIST mode",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_age_days = 0
+}`,
+			Config:   nist,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Passwords expire in NIST mode",
+			Content: `
+resource "okta_policy_password" "example" {
+  password_max_age_days = 90
+}`,
+			Config: nist,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordMaxAgeRule(),
+					Message: "password_max_age_days is 90, but passwords should not expire",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordMaxAgeRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_OktaPolicyPasswordMaxAgeRule_InvalidMode(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"resource.tf": `
+resource "okta_policy_password" "example" {
+}`,
+		".tflint.hcl": `
+rule "okta_policy_password_max_age" {
+  enabled = true
+  mode    = "never"
+}`,
+	})
+
+	if err := NewOktaPolicyPasswordMaxAgeRule().Check(runner); err == nil {
+		t.Fatal("Expected an error for an invalid mode")
+	}
+}
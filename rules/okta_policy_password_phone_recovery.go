@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyPasswordPhoneRecoveryRule checks that password policies do not allow recovery by SMS or voice call,
+// which are exposed to SIM swapping. Both are inactive unless set.
+type OktaPolicyPasswordPhoneRecoveryRule struct {
+	tflint.DefaultRule
+	resourceType   string
+	attributeNames []string
+}
+
+func NewOktaPolicyPasswordPhoneRecoveryRule() *OktaPolicyPasswordPhoneRecoveryRule {
+	return &OktaPolicyPasswordPhoneRecoveryRule{
+		resourceType:   "okta_policy_password",
+		attributeNames: []string{"sms_recovery", "call_recovery"},
+	}
+}
+
+func (r *OktaPolicyPasswordPhoneRecoveryRule) Name() string {
+	return "okta_policy_password_phone_recovery"
+}
+
+func (r *OktaPolicyPasswordPhoneRecoveryRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyPasswordPhoneRecoveryRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyPasswordPhoneRecoveryRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	attributes := []hclext.AttributeSchema{}
+	for _, name := range r.attributeNames {
+		attributes = append(attributes, hclext.AttributeSchema{Name: name})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, name := range r.attributeNames {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(status string) error {
+				if status != "INACTIVE" {
+					return runner.EmitIssue(r, fmt.Sprintf("%s must be INACTIVE, recovery by phone is vulnerable to SIM swapping", name), attribute.Range)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
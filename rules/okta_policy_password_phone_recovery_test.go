@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyPasswordPhoneRecoveryRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Phone recovery inactive",
+			Content: `
+resource "okta_policy_password" "example" {
+  sms_recovery  = "INACTIVE"
+  call_recovery = "INACTIVE"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Phone recovery omitted",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Phone recovery active",
+			Content: `
+resource "okta_policy_password" "example" {
+  sms_recovery  = "ACTIVE"
+  call_recovery = "ACTIVE"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordPhoneRecoveryRule(),
+					Message: "sms_recovery must be INACTIVE, recovery by phone is vulnerable to SIM swapping",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyPasswordPhoneRecoveryRule(),
+					Message: "call_recovery must be INACTIVE, recovery by phone is vulnerable to SIM swapping",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 27},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordPhoneRecoveryRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
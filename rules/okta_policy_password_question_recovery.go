@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyPasswordQuestionRecoveryRule checks that password policies do not allow recovery by security question,
+// or, when configured to allow it, that answers have a minimum length.
+type OktaPolicyPasswordQuestionRecoveryRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	statusAttribute string
+	lengthAttribute string
+	defaultStatus   string
+	defaultLength   int
+	minLength       int
+}
+
+// Allow permits recovery by security question, whose answers must then be at least MinLength characters long.
+type oktaPolicyPasswordQuestionRecoveryRuleConfig struct {
+	Allow     bool `hclext:"allow,optional"`
+	MinLength int  `hclext:"min_length,optional"`
+}
+
+func NewOktaPolicyPasswordQuestionRecoveryRule() *OktaPolicyPasswordQuestionRecoveryRule {
+	return &OktaPolicyPasswordQuestionRecoveryRule{
+		resourceType:    "okta_policy_password",
+		statusAttribute: "question_recovery",
+		lengthAttribute: "question_min_length",
+		defaultStatus:   "ACTIVE",
+		defaultLength:   4,
+		minLength:       8,
+	}
+}
+
+func (r *OktaPolicyPasswordQuestionRecoveryRule) Name() string {
+	return "okta_policy_password_question_recovery"
+}
+
+func (r *OktaPolicyPasswordQuestionRecoveryRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyPasswordQuestionRecoveryRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyPasswordQuestionRecoveryRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyPasswordQuestionRecoveryRuleConfig{MinLength: r.minLength}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.statusAttribute}, {Name: r.lengthAttribute}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		status := r.defaultStatus
+		attribute, exists := resource.Body.Attributes[r.statusAttribute]
+		if exists {
+			status = ""
+			err := runner.EvaluateExpr(attribute.Expr, func(value string) error {
+				status = value
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if status != "ACTIVE" {
+			continue
+		}
+
+		if !config.Allow {
+			if exists {
+				err = runner.EmitIssue(r, "Recovery by security question must be disabled, set question_recovery to INACTIVE", attribute.Range)
+			} else {
+				err = runner.EmitIssue(r, "Recovery by security question is enabled by default, set question_recovery to INACTIVE", resource.DefRange)
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := r.checkMinLength(runner, config, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *OktaPolicyPasswordQuestionRecoveryRule) checkMinLength(runner tflint.Runner, config oktaPolicyPasswordQuestionRecoveryRuleConfig, resource *hclext.Block) error {
+	attribute, exists := resource.Body.Attributes[r.lengthAttribute]
+	if !exists {
+		if r.defaultLength < config.MinLength {
+			return runner.EmitIssue(r, fmt.Sprintf("%s defaults to %d, which is below the minimum of %d", r.lengthAttribute, r.defaultLength, config.MinLength), resource.DefRange)
+		}
+		return nil
+	}
+
+	return runner.EvaluateExpr(attribute.Expr, func(length int) error {
+		if length < config.MinLength {
+			return runner.EmitIssue(r, fmt.Sprintf("%s is %d, which is below the minimum of %d", r.lengthAttribute, length, config.MinLength), attribute.Range)
+		}
+		return nil
+	}, nil)
+}
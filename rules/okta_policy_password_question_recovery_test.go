@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyPasswordQuestionRecoveryRule(t *testing.T) {
+	allow := `
+rule "okta_policy_password_question_recovery" {
+  enabled = true
+  allow   = true
+}`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Question recovery inactive",
+			Content: `
+resource "okta_policy_password" "example" {
+  question_recovery = "INACTIVE"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Question recovery active",
+			Content: `
+resource "okta_policy_password" "example" {
+  question_recovery = "ACTIVE"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordQuestionRecoveryRule(),
+					Message: "Recovery by security question must be disabled, set question_recovery to INACTIVE",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "Question recovery omitted",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordQuestionRecoveryRule(),
+					Message: "Recovery by security question is enabled by default, set question_recovery to INACTIVE",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "Allowed with long answers",
+			Content: `
+resource "okta_policy_password" "example" {
+  question_recovery   = "ACTIVE"
+  question_min_length = 10
+}`,
+			Config:   allow,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Allowed with short answers",
+			Content: `
+resource "okta_policy_password" "example" {
+  question_recovery   = "ACTIVE"
+  question_min_length = 4
+}`,
+			Config: allow,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordQuestionRecoveryRule(),
+					Message: "question_min_length is 4, which is below the minimum of 8",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "Allowed with default answer length",
+			Content: `
+resource "okta_policy_password" "example" {
+}`,
+			Config: `
+rule "okta_policy_password_question_recovery" {
+  enabled    = true
+  allow      = true
+  min_length = 6
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyPasswordQuestionRecoveryRule(),
+					Message: "question_min_length defaults to 4, which is below the minimum of 6",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyPasswordQuestionRecoveryRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,227 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyRuleIdpDiscoveryRule checks the conditions of IdP discovery rules: user identifier patterns
+// must not be empty or overlap with the domain patterns of other rules of the same policy,
+// and rules routing to an identity provider must not match any platform.
+type OktaPolicyRuleIdpDiscoveryRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	policyAttribute    string
+	idpAttribute       string
+	patternsBlock      string
+	platformBlock      string
+	providersBlock     string
+	matchTypeAttribute string
+	valueAttribute     string
+	typeAttribute      string
+	osTypeAttribute    string
+}
+
+func NewOktaPolicyRuleIdpDiscoveryRule() *OktaPolicyRuleIdpDiscoveryRule {
+	return &OktaPolicyRuleIdpDiscoveryRule{
+		resourceType:       "okta_policy_rule_idp_discovery",
+		policyAttribute:    "policy_id",
+		idpAttribute:       "idp_id",
+		patternsBlock:      "user_identifier_patterns",
+		platformBlock:      "platform_include",
+		providersBlock:     "idp_providers",
+		matchTypeAttribute: "match_type",
+		valueAttribute:     "value",
+		typeAttribute:      "type",
+		osTypeAttribute:    "os_type",
+	}
+}
+
+func (r *OktaPolicyRuleIdpDiscoveryRule) Name() string {
+	return "okta_policy_rule_idp_discovery"
+}
+
+func (r *OktaPolicyRuleIdpDiscoveryRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyRuleIdpDiscoveryRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// domainPattern is a SUFFIX or EQUALS user identifier pattern of an IdP discovery rule.
+type domainPattern struct {
+	matchType string
+	value     string
+	address   string
+}
+
+// overlaps reports whether a user identifier could match both patterns.
+func (p domainPattern) overlaps(other domainPattern) bool {
+	switch {
+	case p.matchType == "EQUALS" && other.matchType == "EQUALS":
+		return p.value == other.value
+	case p.matchType == "EQUALS":
+		return strings.HasSuffix(p.value, other.value)
+	case other.matchType == "EQUALS":
+		return strings.HasSuffix(other.value, p.value)
+	default:
+		return strings.HasSuffix(p.value, other.value) || strings.HasSuffix(other.value, p.value)
+	}
+}
+
+func (r *OktaPolicyRuleIdpDiscoveryRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: r.idpAttribute}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.patternsBlock,
+				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: r.matchTypeAttribute}, {Name: r.valueAttribute}}},
+			},
+			{
+				Type: r.platformBlock,
+				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: r.typeAttribute}, {Name: r.osTypeAttribute}}},
+			},
+			{
+				Type: r.providersBlock,
+				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: r.typeAttribute}}},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// patterns maps each policy to the domain patterns of the rules checked so far.
+	patterns := map[string][]domainPattern{}
+
+	for _, resource := range resources.Blocks {
+		policy := ""
+		if policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]; exists {
+			policy, err = resourceKey(runner, policyAttribute)
+			if err != nil {
+				return err
+			}
+		}
+
+		address := resource.Labels[0] + "." + resource.Labels[1]
+		var found []domainPattern
+
+		for _, block := range resource.Body.Blocks.OfType(r.patternsBlock) {
+			valueAttribute, exists := block.Body.Attributes[r.valueAttribute]
+			if !exists {
+				if err := runner.EmitIssue(r, "User identifier pattern has no value and matches every user", block.DefRange); err != nil {
+					return err
+				}
+				continue
+			}
+
+			matchType := ""
+			if matchTypeAttribute, exists := block.Body.Attributes[r.matchTypeAttribute]; exists {
+				err := runner.EvaluateExpr(matchTypeAttribute.Expr, func(value string) error {
+					matchType = value
+					return nil
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			err := runner.EvaluateExpr(valueAttribute.Expr, func(value string) error {
+				if strings.TrimSpace(value) == "" {
+					return runner.EmitIssue(r, "User identifier pattern is empty and matches every user", valueAttribute.Range)
+				}
+				if policy == "" || (matchType != "SUFFIX" && matchType != "EQUALS") {
+					return nil
+				}
+
+				pattern := domainPattern{matchType: matchType, value: strings.ToLower(value), address: address}
+				for _, other := range patterns[policy] {
+					if pattern.overlaps(other) {
+						return runner.EmitIssue(r, fmt.Sprintf("Domain pattern %q overlaps with %q of %s in policy %s", value, other.value, other.address, policy), valueAttribute.Range)
+					}
+				}
+				found = append(found, pattern)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if policy != "" {
+			patterns[policy] = append(patterns[policy], found...)
+		}
+
+		routes, err := r.routesToIdentityProvider(runner, resource)
+		if err != nil {
+			return err
+		}
+		if !routes {
+			continue
+		}
+
+		for _, block := range resource.Body.Blocks.OfType(r.platformBlock) {
+			typeAttribute, exists := block.Body.Attributes[r.typeAttribute]
+			if !exists {
+				continue
+			}
+
+			anyOS := true
+			if osTypeAttribute, exists := block.Body.Attributes[r.osTypeAttribute]; exists {
+				anyOS = false
+				err := runner.EvaluateExpr(osTypeAttribute.Expr, func(osType string) error {
+					anyOS = osType == "ANY"
+					return nil
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+			if !anyOS {
+				continue
+			}
+
+			err := runner.EvaluateExpr(typeAttribute.Expr, func(platform string) error {
+				if platform != "ANY" {
+					return nil
+				}
+				return runner.EmitIssue(r, "IdP routing rule matches any platform, restrict platform_include to the platforms it is meant for", typeAttribute.Range)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// routesToIdentityProvider reports whether the rule routes users to an identity provider other than Okta.
+func (r *OktaPolicyRuleIdpDiscoveryRule) routesToIdentityProvider(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	if _, exists := resource.Body.Attributes[r.idpAttribute]; exists {
+		return true, nil
+	}
+
+	routes := false
+	for _, block := range resource.Body.Blocks.OfType(r.providersBlock) {
+		typeAttribute, exists := block.Body.Attributes[r.typeAttribute]
+		if !exists {
+			continue
+		}
+		err := runner.EvaluateExpr(typeAttribute.Expr, func(providerType string) error {
+			routes = routes || providerType != "OKTA"
+			return nil
+		}, nil)
+		if err != nil {
+			return false, err
+		}
+	}
+	return routes, nil
+}
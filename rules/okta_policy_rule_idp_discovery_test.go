@@ -0,0 +1,201 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyRuleIdpDiscoveryRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Specific rules",
+			Content: `
+resource "okta_policy_rule_idp_discovery" "corp" {
+  policy_id = okta_policy_idp_discovery.example.id
+  idp_id    = okta_idp_saml.corp.id
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+    value      = "corp.example.com"
+  }
+
+  platform_include {
+    type    = "DESKTOP"
+    os_type = "ANY"
+  }
+}
+
+resource "okta_policy_rule_idp_discovery" "partners" {
+  policy_id = okta_policy_idp_discovery.example.id
+  idp_id    = okta_idp_saml.partners.id
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+    value      = "partner.com"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Empty pattern",
+			Content: `
+resource "okta_policy_rule_idp_discovery" "corp" {
+  policy_id = okta_policy_idp_discovery.example.id
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+    value      = ""
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleIdpDiscoveryRule(),
+					Message: "User identifier pattern is empty and matches every user",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 5},
+						End:      hcl.Pos{Line: 7, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "Pattern without value",
+			Content: `
+resource "okta_policy_rule_idp_discovery" "corp" {
+  policy_id = okta_policy_idp_discovery.example.id
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleIdpDiscoveryRule(),
+					Message: "User identifier pattern has no value and matches every user",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "Overlapping domain patterns",
+			Content: `
+resource "okta_policy_rule_idp_discovery" "example" {
+  policy_id = okta_policy_idp_discovery.example.id
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+    value      = "example.com"
+  }
+}
+
+resource "okta_policy_rule_idp_discovery" "corp" {
+  policy_id = okta_policy_idp_discovery.example.id
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+    value      = "corp.example.com"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleIdpDiscoveryRule(),
+					Message: `Domain pattern "corp.example.com" overlaps with "example.com" of okta_policy_rule_idp_discovery.example in policy okta_policy_idp_discovery.example`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 16, Column: 5},
+						End:      hcl.Pos{Line: 16, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "Same domain in different policies",
+			Content: `
+resource "okta_policy_rule_idp_discovery" "example" {
+  policy_id = okta_policy_idp_discovery.example.id
+
+  user_identifier_patterns {
+    match_type = "EQUALS"
+    value      = "admin@example.com"
+  }
+}
+
+resource "okta_policy_rule_idp_discovery" "other" {
+  policy_id = okta_policy_idp_discovery.other.id
+
+  user_identifier_patterns {
+    match_type = "SUFFIX"
+    value      = "example.com"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Routing rule on any platform",
+			Content: `
+resource "okta_policy_rule_idp_discovery" "corp" {
+  policy_id = okta_policy_idp_discovery.example.id
+
+  idp_providers {
+    id   = okta_idp_saml.corp.id
+    type = "SAML2"
+  }
+
+  platform_include {
+    type = "ANY"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleIdpDiscoveryRule(),
+					Message: "IdP routing rule matches any platform, restrict platform_include to the platforms it is meant for",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 5},
+						End:      hcl.Pos{Line: 11, Column: 17},
+					},
+				},
+			},
+		},
+		{
+			Name: "Okta rule on any platform",
+			Content: `
+resource "okta_policy_rule_idp_discovery" "default" {
+  policy_id = okta_policy_idp_discovery.example.id
+
+  idp_providers {
+    type = "OKTA"
+  }
+
+  platform_include {
+    type    = "ANY"
+    os_type = "ANY"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyRuleIdpDiscoveryRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,158 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyRulePasswordSelfServiceRule checks that password policy rules allow or deny self-service password
+// reset and unlock as configured. Policy blocks set different permissions for the rules of matching policies.
+type OktaPolicyRulePasswordSelfServiceRule struct {
+	tflint.DefaultRule
+	resourceType       string
+	policyType         string
+	policyAttribute    string
+	nameAttribute      string
+	permissionDefaults map[string]string
+}
+
+// PasswordReset and PasswordUnlock are the permissions required of each rule, either ALLOW or DENY.
+// An empty permission is not checked.
+type oktaPolicyRulePasswordSelfServiceRuleConfig struct {
+	PasswordReset  string                                          `hclext:"password_reset,optional"`
+	PasswordUnlock string                                          `hclext:"password_unlock,optional"`
+	Policies       []oktaPolicyRulePasswordSelfServicePolicyConfig `hclext:"policy,block"`
+}
+
+// Match is a regular expression matching the names of the password policies whose rules need these permissions.
+// Permissions which are not set fall back to the rule-level permissions.
+type oktaPolicyRulePasswordSelfServicePolicyConfig struct {
+	Match          string `hclext:"match"`
+	PasswordReset  string `hclext:"password_reset,optional"`
+	PasswordUnlock string `hclext:"password_unlock,optional"`
+
+	match *regexp.Regexp
+}
+
+func NewOktaPolicyRulePasswordSelfServiceRule() *OktaPolicyRulePasswordSelfServiceRule {
+	return &OktaPolicyRulePasswordSelfServiceRule{
+		resourceType:    "okta_policy_rule_password",
+		policyType:      "okta_policy_password",
+		policyAttribute: "policy_id",
+		nameAttribute:   "name",
+		permissionDefaults: map[string]string{
+			"password_reset":  "ALLOW",
+			"password_unlock": "DENY",
+		},
+	}
+}
+
+func (r *OktaPolicyRulePasswordSelfServiceRule) Name() string {
+	return "okta_policy_rule_password_self_service"
+}
+
+func (r *OktaPolicyRulePasswordSelfServiceRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaPolicyRulePasswordSelfServiceRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyRulePasswordSelfServiceRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyRulePasswordSelfServiceRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if err := r.validate(config.PasswordReset, config.PasswordUnlock); err != nil {
+		return err
+	}
+	for i := range config.Policies {
+		policy := &config.Policies[i]
+		if err := r.validate(policy.PasswordReset, policy.PasswordUnlock); err != nil {
+			return err
+		}
+		var err error
+		policy.match, err = regexp.Compile(policy.Match)
+		if err != nil {
+			return fmt.Errorf("invalid policy match pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	policyNames, err := resourceNames(runner, r.policyType, r.nameAttribute)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: "password_reset"}, {Name: "password_unlock"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		required := map[string]string{"password_reset": config.PasswordReset, "password_unlock": config.PasswordUnlock}
+
+		if attribute, exists := resource.Body.Attributes[r.policyAttribute]; exists {
+			if policyName, ok := referencedName(attribute.Expr, policyNames); ok {
+				for _, policy := range config.Policies {
+					if !policy.match.MatchString(policyName) {
+						continue
+					}
+					if policy.PasswordReset != "" {
+						required["password_reset"] = policy.PasswordReset
+					}
+					if policy.PasswordUnlock != "" {
+						required["password_unlock"] = policy.PasswordUnlock
+					}
+					break
+				}
+			}
+		}
+
+		for _, name := range []string{"password_reset", "password_unlock"} {
+			if err := r.checkPermission(runner, resource, name, required[name]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *OktaPolicyRulePasswordSelfServiceRule) validate(permissions ...string) error {
+	for _, permission := range permissions {
+		if permission != "" && permission != "ALLOW" && permission != "DENY" {
+			return fmt.Errorf("invalid permission %q for %s rule: must be ALLOW or DENY", permission, r.Name())
+		}
+	}
+	return nil
+}
+
+func (r *OktaPolicyRulePasswordSelfServiceRule) checkPermission(runner tflint.Runner, resource *hclext.Block, name string, required string) error {
+	if required == "" {
+		return nil
+	}
+
+	attribute, exists := resource.Body.Attributes[name]
+	if !exists {
+		if r.permissionDefaults[name] != required {
+			return runner.EmitIssue(r, fmt.Sprintf("%s defaults to %s, but must be %s", name, r.permissionDefaults[name], required), resource.DefRange)
+		}
+		return nil
+	}
+
+	return runner.EvaluateExpr(attribute.Expr, func(permission string) error {
+		if permission != required {
+			return runner.EmitIssue(r, fmt.Sprintf("%s is %s, but must be %s", name, permission, required), attribute.Range)
+		}
+		return nil
+	}, nil)
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyRulePasswordSelfServiceRule(t *testing.T) {
+	config := `
+rule "okta_policy_rule_password_self_service" {
+  enabled         = true
+  password_unlock = "ALLOW"
+
+  policy {
+    match          = "^Admin"
+    password_reset = "DENY"
+  }
+}`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Permissions match",
+			Content: `
+resource "okta_policy_password" "admin" {
+  name = "Admin password policy"
+}
+
+resource "okta_policy_rule_password" "admin" {
+  policy_id       = okta_policy_password.admin.id
+  password_reset  = "DENY"
+  password_unlock = "ALLOW"
+}
+
+resource "okta_policy_rule_password" "other" {
+  policy_id       = "00p1234567890abcdef"
+  password_unlock = "ALLOW"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Self-service reset allowed for admin policy",
+			Content: `
+resource "okta_policy_password" "admin" {
+  name = "Admin password policy"
+}
+
+resource "okta_policy_rule_password" "admin" {
+  policy_id       = okta_policy_password.admin.id
+  password_unlock = "ALLOW"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRulePasswordSelfServiceRule(),
+					Message: "password_reset defaults to ALLOW, but must be DENY",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "Self-service unlock denied",
+			Content: `
+resource "okta_policy_rule_password" "example" {
+  policy_id       = "00p1234567890abcdef"
+  password_unlock = "DENY"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRulePasswordSelfServiceRule(),
+					Message: "password_unlock is DENY, but must be ALLOW",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 27},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyRulePasswordSelfServiceRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
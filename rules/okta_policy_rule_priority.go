@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyRulePriorityRule checks that no two rules of the same policy have the same priority,
+// since Okta reorders them on apply and Terraform then reports a difference on every plan.
+type OktaPolicyRulePriorityRule struct {
+	tflint.DefaultRule
+	resourceTypes     []string
+	policyAttribute   string
+	priorityAttribute string
+}
+
+func NewOktaPolicyRulePriorityRule() *OktaPolicyRulePriorityRule {
+	return &OktaPolicyRulePriorityRule{
+		resourceTypes:     []string{"okta_policy_rule_*", "okta_app_signon_policy_rule"},
+		policyAttribute:   "policy_id",
+		priorityAttribute: "priority",
+	}
+}
+
+func (r *OktaPolicyRulePriorityRule) Name() string {
+	return "okta_policy_rule_priority"
+}
+
+func (r *OktaPolicyRulePriorityRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyRulePriorityRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyRulePriorityRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	resources, err := getResourcesContent(runner, r.resourceTypes, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: r.priorityAttribute}},
+	})
+	if err != nil {
+		return err
+	}
+
+	// priorities maps each policy to the address of the first rule found with each priority.
+	priorities := map[string]map[int]string{}
+
+	for _, resource := range resources {
+		policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]
+		if !exists {
+			continue
+		}
+		priorityAttribute, exists := resource.Body.Attributes[r.priorityAttribute]
+		if !exists {
+			continue
+		}
+
+		policy, err := resourceKey(runner, policyAttribute)
+		if err != nil {
+			return err
+		}
+		if policy == "" {
+			continue
+		}
+		if priorities[policy] == nil {
+			priorities[policy] = map[int]string{}
+		}
+
+		address := resource.Labels[0] + "." + resource.Labels[1]
+		err = runner.EvaluateExpr(priorityAttribute.Expr, func(priority int) error {
+			if first, exists := priorities[policy][priority]; exists {
+				return runner.EmitIssue(r, fmt.Sprintf("Priority %d is already used by %s in policy %s", priority, first, policy), priorityAttribute.Range)
+			}
+			priorities[policy][priority] = address
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
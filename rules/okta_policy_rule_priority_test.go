@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyRulePriorityRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Distinct priorities",
+			Content: `
+resource "okta_policy_rule_signon" "first" {
+  policy_id = okta_policy_signon.example.id
+  priority  = 1
+}
+
+resource "okta_policy_rule_signon" "second" {
+  policy_id = okta_policy_signon.example.id
+  priority  = 2
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Same priority in different policies",
+			Content: `
+resource "okta_app_signon_policy_rule" "first" {
+  policy_id = okta_app_signon_policy.first.id
+  priority  = 1
+}
+
+resource "okta_app_signon_policy_rule" "second" {
+  policy_id = "rst1234567890abcdef"
+  priority  = 1
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Duplicate priorities",
+			Content: `
+resource "okta_policy_rule_mfa" "first" {
+  policy_id = okta_policy_mfa.example.id
+  priority  = 1
+}
+
+resource "okta_policy_rule_mfa" "second" {
+  policy_id = okta_policy_mfa.example.id
+  priority  = 1
+}
+
+resource "okta_policy_rule_mfa" "third" {
+  policy_id = okta_policy_mfa.example.id
+  priority  = 1
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRulePriorityRule(),
+					Message: "Priority 1 is already used by okta_policy_rule_mfa.first in policy okta_policy_mfa.example",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 16},
+					},
+				},
+				{
+					Rule:    NewOktaPolicyRulePriorityRule(),
+					Message: "Priority 1 is already used by okta_policy_rule_mfa.first in policy okta_policy_mfa.example",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 14, Column: 3},
+						End:      hcl.Pos{Line: 14, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "Duplicate priorities for a literal policy ID",
+			Content: `
+resource "okta_app_signon_policy_rule" "first" {
+  policy_id = "rst1234567890abcdef"
+  priority  = 2
+}
+
+resource "okta_app_signon_policy_rule" "second" {
+  policy_id = "rst1234567890abcdef"
+  priority  = 2
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRulePriorityRule(),
+					Message: "Priority 2 is already used by okta_app_signon_policy_rule.first in policy rst1234567890abcdef",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 16},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyRulePriorityRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
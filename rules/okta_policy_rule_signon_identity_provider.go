@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyRuleSignonIdentityProviderRule checks that sign-on policy rules only apply to users
+// authenticated by an allowed identity provider, so that any federated login cannot bypass them.
+type OktaPolicyRuleSignonIdentityProviderRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	policyType      string
+	policyAttribute string
+	attributeName   string
+	defaultValue    string
+	allowed         []string
+}
+
+// Allowed lists the identity providers rules may apply to, any of OKTA and SPECIFIC_IDP.
+// Policies is a regular expression matching the names of the sign-on policies whose rules are checked,
+// all rules are checked if it is empty.
+type oktaPolicyRuleSignonIdentityProviderRuleConfig struct {
+	Allowed  []string `hclext:"allowed,optional"`
+	Policies string   `hclext:"policies,optional"`
+}
+
+func NewOktaPolicyRuleSignonIdentityProviderRule() *OktaPolicyRuleSignonIdentityProviderRule {
+	return &OktaPolicyRuleSignonIdentityProviderRule{
+		resourceType:    "okta_policy_rule_signon",
+		policyType:      "okta_policy_signon",
+		policyAttribute: "policy_id",
+		attributeName:   "identity_provider",
+		defaultValue:    "ANY",
+		allowed:         []string{"OKTA", "SPECIFIC_IDP"},
+	}
+}
+
+func (r *OktaPolicyRuleSignonIdentityProviderRule) Name() string {
+	return "okta_policy_rule_signon_identity_provider"
+}
+
+func (r *OktaPolicyRuleSignonIdentityProviderRule) Enabled() bool {
+	return false
+}
+
+func (r *OktaPolicyRuleSignonIdentityProviderRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyRuleSignonIdentityProviderRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyRuleSignonIdentityProviderRuleConfig{Allowed: r.allowed}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var policies *regexp.Regexp
+	if config.Policies != "" {
+		var err error
+		policies, err = regexp.Compile(config.Policies)
+		if err != nil {
+			return fmt.Errorf("invalid policies pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	policyNames, err := resourceNames(runner, r.policyType, "name")
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("must be one of %s, so that federated logins cannot bypass the rule", strings.Join(config.Allowed, ", "))
+
+	for _, resource := range resources.Blocks {
+		if policies != nil {
+			policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]
+			if !exists {
+				continue
+			}
+			policyName, ok := referencedName(policyAttribute.Expr, policyNames)
+			if !ok || !policies.MatchString(policyName) {
+				continue
+			}
+		}
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if !slices.Contains(config.Allowed, r.defaultValue) {
+				err := runner.EmitIssue(r, fmt.Sprintf("%s defaults to %s, but %s", r.attributeName, r.defaultValue, message), resource.DefRange)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(provider string) error {
+			if !slices.Contains(config.Allowed, provider) {
+				return runner.EmitIssue(r, fmt.Sprintf("%s is %s, but %s", r.attributeName, provider, message), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyRuleSignonIdentityProviderRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Okta identity provider",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  identity_provider = "OKTA"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Any identity provider",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  identity_provider = "ANY"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonIdentityProviderRule(),
+					Message: "identity_provider is ANY, but must be one of OKTA, SPECIFIC_IDP, so that federated logins cannot bypass the rule",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Default identity provider",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonIdentityProviderRule(),
+					Message: "identity_provider defaults to ANY, but must be one of OKTA, SPECIFIC_IDP, so that federated logins cannot bypass the rule",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "Only Okta allowed",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  identity_provider = "SPECIFIC_IDP"
+}`,
+			Config: `
+rule "okta_policy_rule_signon_identity_provider" {
+  enabled = true
+  allowed = ["OKTA"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonIdentityProviderRule(),
+					Message: "identity_provider is SPECIFIC_IDP, but must be one of OKTA, so that federated logins cannot bypass the rule",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "Policy not matched",
+			Content: `
+resource "okta_policy_signon" "everyone" {
+  name = "Everyone"
+}
+
+resource "okta_policy_rule_signon" "example" {
+  policy_id         = okta_policy_signon.everyone.id
+  identity_provider = "ANY"
+}`,
+			Config: `
+rule "okta_policy_rule_signon_identity_provider" {
+  enabled  = true
+  policies = "(?i)admin"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyRuleSignonIdentityProviderRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyRuleSignonMfaRule checks that sign-on policy rules which allow access require MFA,
+// either with mfa_required or with a factor_sequence block requiring a secondary factor.
+type OktaPolicyRuleSignonMfaRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	accessAttribute string
+	mfaAttribute    string
+	nameAttribute   string
+	sequenceBlock   string
+	secondaryBlock  string
+}
+
+// Exempt lists the names of rules which may allow access without MFA, such as break-glass rules.
+type oktaPolicyRuleSignonMfaRuleConfig struct {
+	Exempt []string `hclext:"exempt,optional"`
+}
+
+func NewOktaPolicyRuleSignonMfaRule() *OktaPolicyRuleSignonMfaRule {
+	return &OktaPolicyRuleSignonMfaRule{
+		resourceType:    "okta_policy_rule_signon",
+		accessAttribute: "access",
+		mfaAttribute:    "mfa_required",
+		nameAttribute:   "name",
+		sequenceBlock:   "factor_sequence",
+		secondaryBlock:  "secondary_criteria",
+	}
+}
+
+func (r *OktaPolicyRuleSignonMfaRule) Name() string {
+	return "okta_policy_rule_signon_mfa"
+}
+
+func (r *OktaPolicyRuleSignonMfaRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyRuleSignonMfaRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyRuleSignonMfaRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyRuleSignonMfaRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.accessAttribute}, {Name: r.mfaAttribute}, {Name: r.nameAttribute}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.sequenceBlock,
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{{Type: r.secondaryBlock, Body: &hclext.BodySchema{}}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		access := "ALLOW"
+		if attribute, exists := resource.Body.Attributes[r.accessAttribute]; exists {
+			access = ""
+			err := runner.EvaluateExpr(attribute.Expr, func(value string) error {
+				access = value
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if access != "ALLOW" || r.hasSecondaryFactor(resource) {
+			continue
+		}
+
+		exempt := false
+		if nameAttribute, exists := resource.Body.Attributes[r.nameAttribute]; exists && len(config.Exempt) > 0 {
+			err := runner.EvaluateExpr(nameAttribute.Expr, func(name string) error {
+				exempt = slices.Contains(config.Exempt, name)
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if exempt {
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes[r.mfaAttribute]
+		if !exists {
+			err := runner.EmitIssue(r, "Sign-on policy rule allows access without MFA, set mfa_required to true", resource.DefRange)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(mfaRequired bool) error {
+			if !mfaRequired {
+				return runner.EmitIssue(r, "Sign-on policy rule allows access without MFA, set mfa_required to true", attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasSecondaryFactor reports whether the rule's factor sequence requires a factor after the primary one.
+func (r *OktaPolicyRuleSignonMfaRule) hasSecondaryFactor(resource *hclext.Block) bool {
+	for _, sequence := range resource.Body.Blocks {
+		if sequence.Type == r.sequenceBlock && len(sequence.Body.Blocks) > 0 {
+			return true
+		}
+	}
+	return false
+}
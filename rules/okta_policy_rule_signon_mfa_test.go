@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyRuleSignonMfaRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "MFA required",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  access       = "ALLOW"
+  mfa_required = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Access denied",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  access = "DENY"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Factor sequence with secondary factor",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  access = "ALLOW"
+
+  factor_sequence {
+    primary_criteria_provider    = "OKTA"
+    primary_criteria_factor_type = "password"
+
+    secondary_criteria {
+      provider    = "OKTA"
+      factor_type = "push"
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "MFA not required",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  access       = "ALLOW"
+  mfa_required = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonMfaRule(),
+					Message: "Sign-on policy rule allows access without MFA, set mfa_required to true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "Defaults",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonMfaRule(),
+					Message: "Sign-on policy rule allows access without MFA, set mfa_required to true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "Exempt rule",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  name         = "Break glass"
+  mfa_required = false
+}`,
+			Config: `
+rule "okta_policy_rule_signon_mfa" {
+  enabled = true
+  exempt  = ["Break glass"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyRuleSignonMfaRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyRuleSignonNetworkZoneRule checks that the rules of privileged sign-on policies
+// only apply to the network zones they include or exclude.
+type OktaPolicyRuleSignonNetworkZoneRule struct {
+	tflint.DefaultRule
+	resourceType        string
+	policyType          string
+	policyAttribute     string
+	connectionAttribute string
+	includesAttribute   string
+	excludesAttribute   string
+	privileged          string
+}
+
+// Privileged is a regular expression matching the names of the sign-on policies to check.
+type oktaPolicyRuleSignonNetworkZoneRuleConfig struct {
+	Privileged string `hclext:"privileged,optional"`
+}
+
+func NewOktaPolicyRuleSignonNetworkZoneRule() *OktaPolicyRuleSignonNetworkZoneRule {
+	return &OktaPolicyRuleSignonNetworkZoneRule{
+		resourceType:        "okta_policy_rule_signon",
+		policyType:          "okta_policy_signon",
+		policyAttribute:     "policy_id",
+		connectionAttribute: "network_connection",
+		includesAttribute:   "network_includes",
+		excludesAttribute:   "network_excludes",
+		privileged:          "(?i)admin",
+	}
+}
+
+func (r *OktaPolicyRuleSignonNetworkZoneRule) Name() string {
+	return "okta_policy_rule_signon_network_zone"
+}
+
+func (r *OktaPolicyRuleSignonNetworkZoneRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyRuleSignonNetworkZoneRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyRuleSignonNetworkZoneRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyRuleSignonNetworkZoneRuleConfig{Privileged: r.privileged}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	privileged, err := regexp.Compile(config.Privileged)
+	if err != nil {
+		return fmt.Errorf("invalid privileged pattern for %s rule: %w", r.Name(), err)
+	}
+
+	policyNames, err := resourceNames(runner, r.policyType, "name")
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.policyAttribute},
+			{Name: r.connectionAttribute},
+			{Name: r.includesAttribute},
+			{Name: r.excludesAttribute},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]
+		if !exists {
+			continue
+		}
+		policyName, ok := referencedName(policyAttribute.Expr, policyNames)
+		if !ok || !privileged.MatchString(policyName) {
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes[r.connectionAttribute]
+		if !exists {
+			err := runner.EmitIssue(r, fmt.Sprintf("Rule of privileged sign-on policy %s applies anywhere by default, restrict it with %s or %s", policyName, r.includesAttribute, r.excludesAttribute), resource.DefRange)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(connection string) error {
+			switch connection {
+			case "ANYWHERE":
+				return runner.EmitIssue(r, fmt.Sprintf("Rule of privileged sign-on policy %s applies anywhere, restrict it with %s or %s", policyName, r.includesAttribute, r.excludesAttribute), attribute.Range)
+			case "ZONE":
+				zones, err := r.zoneCount(runner, resource)
+				if err != nil || zones > 0 {
+					return err
+				}
+				return runner.EmitIssue(r, fmt.Sprintf("Rule of privileged sign-on policy %s restricts network zones but sets neither %s nor %s", policyName, r.includesAttribute, r.excludesAttribute), attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// zoneCount returns the number of zones the rule includes or excludes. Unknown lists count as one zone.
+func (r *OktaPolicyRuleSignonNetworkZoneRule) zoneCount(runner tflint.Runner, resource *hclext.Block) (int, error) {
+	count := 0
+	for _, name := range []string{r.includesAttribute, r.excludesAttribute} {
+		attribute, exists := resource.Body.Attributes[name]
+		if !exists {
+			continue
+		}
+		known := false
+		err := runner.EvaluateExpr(attribute.Expr, func(zones []string) error {
+			known = true
+			count += len(zones)
+			return nil
+		}, nil)
+		if err != nil {
+			return 0, err
+		}
+		if !known {
+			count++
+		}
+	}
+	return count, nil
+}
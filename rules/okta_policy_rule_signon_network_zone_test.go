@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyRuleSignonNetworkZoneRule(t *testing.T) {
+	policies := `
+resource "okta_policy_signon" "admin" {
+  name = "Admin sign-on policy"
+}
+
+resource "okta_policy_signon" "everyone" {
+  name = "Everyone sign-on policy"
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Privileged policy restricted to a zone",
+			Content: policies + `
+resource "okta_policy_rule_signon" "admin" {
+  policy_id          = okta_policy_signon.admin.id
+  network_connection = "ZONE"
+  network_includes   = ["nzo1234567890abcdef"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unprivileged policy applies anywhere",
+			Content: policies + `
+resource "okta_policy_rule_signon" "everyone" {
+  policy_id          = okta_policy_signon.everyone.id
+  network_connection = "ANYWHERE"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Privileged policy applies anywhere",
+			Content: policies + `
+resource "okta_policy_rule_signon" "admin" {
+  policy_id          = okta_policy_signon.admin.id
+  network_connection = "ANYWHERE"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonNetworkZoneRule(),
+					Message: "Rule of privileged sign-on policy Admin sign-on policy applies anywhere, restrict it with network_includes or network_excludes",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 3},
+						End:      hcl.Pos{Line: 12, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "Privileged policy applies anywhere by default",
+			Content: policies + `
+resource "okta_policy_rule_signon" "admin" {
+  policy_id = okta_policy_signon.admin.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonNetworkZoneRule(),
+					Message: "Rule of privileged sign-on policy Admin sign-on policy applies anywhere by default, restrict it with network_includes or network_excludes",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "Privileged policy restricted to no zones",
+			Content: policies + `
+resource "okta_policy_rule_signon" "admin" {
+  policy_id          = okta_policy_signon.admin.id
+  network_connection = "ZONE"
+  network_includes   = []
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonNetworkZoneRule(),
+					Message: "Rule of privileged sign-on policy Admin sign-on policy restricts network zones but sets neither network_includes nor network_excludes",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 3},
+						End:      hcl.Pos{Line: 12, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured privileged pattern",
+			Content: policies + `
+resource "okta_policy_rule_signon" "admin" {
+  policy_id          = okta_policy_signon.admin.id
+  network_connection = "ANYWHERE"
+}`,
+			Config: `
+rule "okta_policy_rule_signon_network_zone" {
+  enabled    = true
+  privileged = "^Finance"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOktaPolicyRuleSignonNetworkZoneRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
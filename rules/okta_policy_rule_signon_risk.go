@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OktaPolicyRuleSignonRiskRule warns about sign-on policy rules which apply at any risk level
+// and to any behavior, so they do not take part in risk-based authentication.
+type OktaPolicyRuleSignonRiskRule struct {
+	tflint.DefaultRule
+	resourceType    string
+	policyType      string
+	policyAttribute string
+	// riskAttributes includes risc_level, the deprecated spelling of risk_level.
+	riskAttributes     []string
+	behaviorsAttribute string
+}
+
+// Policies is a regular expression matching the names of the sign-on policies whose rules are checked.
+// All rules are checked if it is empty.
+type oktaPolicyRuleSignonRiskRuleConfig struct {
+	Policies string `hclext:"policies,optional"`
+}
+
+func NewOktaPolicyRuleSignonRiskRule() *OktaPolicyRuleSignonRiskRule {
+	return &OktaPolicyRuleSignonRiskRule{
+		resourceType:       "okta_policy_rule_signon",
+		policyType:         "okta_policy_signon",
+		policyAttribute:    "policy_id",
+		riskAttributes:     []string{"risk_level", "risc_level"},
+		behaviorsAttribute: "behaviors",
+	}
+}
+
+func (r *OktaPolicyRuleSignonRiskRule) Name() string {
+	return "okta_policy_rule_signon_risk"
+}
+
+func (r *OktaPolicyRuleSignonRiskRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyRuleSignonRiskRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *OktaPolicyRuleSignonRiskRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyRuleSignonRiskRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var policies *regexp.Regexp
+	if config.Policies != "" {
+		var err error
+		policies, err = regexp.Compile(config.Policies)
+		if err != nil {
+			return fmt.Errorf("invalid policies pattern for %s rule: %w", r.Name(), err)
+		}
+	}
+
+	policyNames, err := resourceNames(runner, r.policyType, "name")
+	if err != nil {
+		return err
+	}
+
+	attributes := []hclext.AttributeSchema{{Name: r.policyAttribute}, {Name: r.behaviorsAttribute}}
+	for _, name := range r.riskAttributes {
+		attributes = append(attributes, hclext.AttributeSchema{Name: name})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: attributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if policies != nil {
+			policyAttribute, exists := resource.Body.Attributes[r.policyAttribute]
+			if !exists {
+				continue
+			}
+			policyName, ok := referencedName(policyAttribute.Expr, policyNames)
+			if !ok || !policies.MatchString(policyName) {
+				continue
+			}
+		}
+
+		anyBehavior, err := r.appliesToAnyBehavior(runner, resource)
+		if err != nil {
+			return err
+		}
+		if !anyBehavior {
+			continue
+		}
+
+		// Unknown risk levels are assumed to be restricted.
+		anyRisk, known := true, true
+		issueRange := resource.DefRange
+		for _, name := range r.riskAttributes {
+			attribute, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+			evaluated := false
+			err := runner.EvaluateExpr(attribute.Expr, func(level string) error {
+				evaluated = true
+				if level != "" && level != "ANY" {
+					anyRisk = false
+				}
+				issueRange = attribute.Range
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+			known = known && evaluated
+		}
+		if !anyRisk || !known {
+			continue
+		}
+
+		err = runner.EmitIssue(r, "Sign-on policy rule applies at any risk level and to any behavior, consider setting risk_level or behaviors", issueRange)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appliesToAnyBehavior reports whether the rule sets no behaviors. Unknown behaviors are assumed to be set.
+func (r *OktaPolicyRuleSignonRiskRule) appliesToAnyBehavior(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	attribute, exists := resource.Body.Attributes[r.behaviorsAttribute]
+	if !exists {
+		return true, nil
+	}
+
+	anyBehavior := false
+	err := runner.EvaluateExpr(attribute.Expr, func(behaviors []string) error {
+		anyBehavior = len(behaviors) == 0
+		return nil
+	}, nil)
+	return anyBehavior, err
+}
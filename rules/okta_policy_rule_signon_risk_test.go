@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OktaPolicyRuleSignonRiskRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Risk level set",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  risk_level = "HIGH"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Risk level unknown",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  risk_level = var.unknown
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Behaviors set",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  risk_level = "ANY"
+  behaviors  = ["New Device"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Any risk level",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  risk_level = "ANY"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonRiskRule(),
+					Message: "Sign-on policy rule applies at any risk level and to any behavior, consider setting risk_level or behaviors",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "Deprecated risk level blank",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  risc_level = ""
+  behaviors  = []
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonRiskRule(),
+					Message: "Sign-on policy rule applies at any risk level and to any behavior, consider setting risk_level or behaviors",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "Nothing set",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonRiskRule(),
+					Message: "Sign-on policy rule applies at any risk level and to any behavior, consider setting risk_level or behaviors",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "Policy not configured",
+			Content: `
+resource "okta_policy_signon" "everyone" {
+  name = "Everyone"
+}
+
+resource "okta_policy_rule_signon" "example" {
+  policy_id = okta_policy_signon.everyone.id
+}`,
+			Config: `
+rule "okta_policy_rule_signon_risk" {
+  enabled  = true
+  policies = "^Admins$"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Policy configured",
+			Content: `
+resource "okta_policy_signon" "admins" {
+  name = "Admins"
+}
+
+resource "okta_policy_rule_signon" "example" {
+  policy_id = okta_policy_signon.admins.id
+}`,
+			Config: `
+rule "okta_policy_rule_signon_risk" {
+  enabled  = true
+  policies = "^Admins$"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonRiskRule(),
+					Message: "Sign-on policy rule applies at any risk level and to any behavior, consider setting risk_level or behaviors",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 45},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyRuleSignonRiskRule()
+
+	for _, tc := range cases {
+		runner := &unknownVariableRunner{helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})}
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type OktaPolicyRuleSignonSessionPersistentRule struct {
+	tflint.DefaultRule
+	resourceType  string
+	attributeName string
+}
+
+// Severity overrides the rule's severity, which is ERROR by default.
+type oktaPolicyRuleSignonSessionPersistentRuleConfig struct {
+	Severity string `hclext:"severity,optional"`
+}
+
+func NewOktaPolicyRuleSignonSessionPersistentRule() *OktaPolicyRuleSignonSessionPersistentRule {
+	return &OktaPolicyRuleSignonSessionPersistentRule{
+		resourceType:  "okta_policy_rule_signon",
+		attributeName: "session_persistent",
+	}
+}
+
+func (r *OktaPolicyRuleSignonSessionPersistentRule) Name() string {
+	return "okta_policy_rule_signon_session_persistent"
+}
+
+func (r *OktaPolicyRuleSignonSessionPersistentRule) Enabled() bool {
+	return true
+}
+
+func (r *OktaPolicyRuleSignonSessionPersistentRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *OktaPolicyRuleSignonSessionPersistentRule) Check(runner tflint.Runner) error {
+	logger.Debug(fmt.Sprintf("checking %s rule", r.Name()))
+
+	config := oktaPolicyRuleSignonSessionPersistentRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	rule, err := withSeverity(r, config.Severity)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.EvaluateExpr(attribute.Expr, func(persistent bool) error {
+			if persistent {
+				return runner.EmitIssue(rule, "Sign-on sessions must not persist across browser restarts, set session_persistent to false", attribute.Range)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
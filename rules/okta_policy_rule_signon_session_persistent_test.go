@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_OktaPolicyRuleSignonSessionPersistentRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Session not persistent",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_persistent = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Session persistence omitted",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Session persistent",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_persistent = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOktaPolicyRuleSignonSessionPersistentRule(),
+					Message: "Sign-on sessions must not persist across browser restarts, set session_persistent to false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured severity",
+			Content: `
+resource "okta_policy_rule_signon" "example" {
+  session_persistent = true
+}`,
+			Config: `
+rule "okta_policy_rule_signon_session_persistent" {
+  enabled  = true
+  severity = "warning"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &ruleWithSeverity{Rule: NewOktaPolicyRuleSignonSessionPersistentRule(), severity: tflint.WARNING},
+					Message: "Sign-on sessions must not persist across browser restarts, set session_persistent to false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOktaPolicyRuleSignonSessionPersistentRule()
+
+	for _, tc := range cases {
+		runner := helper.TestRunner(t, map[string]string{"resource.tf": tc.Content, ".tflint.hcl": tc.Config})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		helper.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
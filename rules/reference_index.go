@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// referenceIndex records which resources are referenced from the bodies of other resources in a module.
+// Only native syntax files are indexed, and references made through locals or modules are not followed.
+type referenceIndex struct {
+	// referrers maps a resource address, such as okta_group.example, to the types of the resources referring to it.
+	referrers map[string][]string
+}
+
+func newReferenceIndex(runner tflint.Runner) (*referenceIndex, error) {
+	index := &referenceIndex{referrers: map[string][]string{}}
+
+	files, err := runner.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type == "resource" && len(block.Labels) == 2 {
+				index.addBody(block.Labels[0], block.Body)
+			}
+		}
+	}
+
+	return index, nil
+}
+
+func (i *referenceIndex) addBody(referrerType string, body *hclsyntax.Body) {
+	for _, attribute := range body.Attributes {
+		for _, traversal := range attribute.Expr.Variables() {
+			if address, ok := resourceAddress(traversal); ok {
+				i.referrers[address] = append(i.referrers[address], referrerType)
+			}
+		}
+	}
+	for _, block := range body.Blocks {
+		i.addBody(referrerType, block.Body)
+	}
+}
+
+// referencedBy reports whether the resource is referenced by a resource whose type matches any of the patterns.
+// Patterns may use shell wildcards, such as okta_policy_*.
+func (i *referenceIndex) referencedBy(address string, patterns []string) bool {
+	for _, referrerType := range i.referrers[address] {
+		if matchesResourceType(referrerType, patterns) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceAddress returns the address of the managed resource a traversal refers to, if any.
+func resourceAddress(traversal hcl.Traversal) (string, bool) {
+	if len(traversal) < 2 {
+		return "", false
+	}
+	switch traversal.RootName() {
+	case "var", "local", "module", "data", "count", "each", "self", "path", "terraform":
+		return "", false
+	}
+	name, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	return traversal.RootName() + "." + name.Name, true
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"path"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// matchesResourceType reports whether the resource type matches any of the patterns,
+// which may use shell wildcards, such as okta_app_*.
+func matchesResourceType(resourceType string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, resourceType); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// getResourcesContent is like Runner.GetResourceContent, but gets resources of every type matching any of the patterns.
+func getResourcesContent(runner tflint.Runner, patterns []string, schema *hclext.BodySchema) (hclext.Blocks, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "resource", LabelNames: []string{"type", "name"}, Body: schema}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources hclext.Blocks
+	for _, resource := range content.Blocks {
+		if matchesResourceType(resource.Labels[0], patterns) {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// resourceNames maps the address of each resource of the type, such as okta_policy_signon.example,
+// to the value of its name attribute, where it is known.
+func resourceNames(runner tflint.Runner, resourceType string, attributeName string) (map[string]string, error) {
+	names := map[string]string{}
+
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: attributeName}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[attributeName]
+		if !exists {
+			continue
+		}
+		err := runner.EvaluateExpr(attribute.Expr, func(name string) error {
+			names[resource.Labels[0]+"."+resource.Labels[1]] = name
+			return nil
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return names, nil
+}
+
+// referencedName returns the name of the first resource in names which the expression refers to.
+func referencedName(expr hcl.Expression, names map[string]string) (string, bool) {
+	for _, traversal := range expr.Variables() {
+		address, ok := resourceAddress(traversal)
+		if !ok {
+			continue
+		}
+		if name, exists := names[address]; exists {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// referencesAny reports whether the expression refers to any resource whose address is set in addresses.
+func referencesAny(expr hcl.Expression, addresses map[string]bool) bool {
+	for _, traversal := range expr.Variables() {
+		if address, ok := resourceAddress(traversal); ok && addresses[address] {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceKey identifies the resource an attribute refers to, such as the application of an assignment,
+// by the address of the resource it references or else by its literal ID.
+func resourceKey(runner tflint.Runner, attribute *hclext.Attribute) (string, error) {
+	for _, traversal := range attribute.Expr.Variables() {
+		if address, ok := resourceAddress(traversal); ok {
+			return address, nil
+		}
+	}
+
+	key := ""
+	err := runner.EvaluateExpr(attribute.Expr, func(id string) error {
+		key = id
+		return nil
+	}, nil)
+	return key, err
+}
@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// RuleSet is the Okta ruleset, extended with configuration in the plugin block.
+type RuleSet struct {
+	tflint.BuiltinRuleSet
+}
+
+type ruleSetConfig struct {
+	NamingConventions []NamingConvention `hclext:"naming_convention,block"`
+}
+
+func (r *RuleSet) ConfigSchema() *hclext.BodySchema {
+	return hclext.ImpliedBodySchema(&ruleSetConfig{})
+}
+
+func (r *RuleSet) ApplyConfig(content *hclext.BodyContent) error {
+	config := ruleSetConfig{}
+	if diags := hclext.DecodeBody(content, nil, &config); diags.HasErrors() {
+		return diags
+	}
+
+	for _, rule := range r.Rules {
+		if rule, ok := rule.(*OktaNamingConventionRule); ok {
+			if err := rule.SetConventions(config.NamingConventions); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_RuleSet_ApplyConfig(t *testing.T) {
+	config := `
+naming_convention {
+  resource_type = "okta_group"
+  prefix        = "iac-"
+}
+
+naming_convention {
+  resource_type = "okta_app_saml"
+  attribute     = "label"
+  case          = "lower"
+}`
+
+	rule := NewOktaNamingConventionRule()
+	ruleset := &RuleSet{BuiltinRuleSet: tflint.BuiltinRuleSet{Rules: []tflint.Rule{rule}}}
+
+	file, diags := hclparse.NewParser().ParseHCL([]byte(config), "plugin.hcl")
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	content, diags := hclext.Content(file.Body, ruleset.ConfigSchema())
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	if err := ruleset.ApplyConfig(content); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	if len(rule.conventions) != 2 {
+		t.Fatalf("Expected 2 naming conventions, got %d", len(rule.conventions))
+	}
+	if rule.conventions[0].Attribute != "name" {
+		t.Fatalf("Expected default attribute name, got %s", rule.conventions[0].Attribute)
+	}
+	if rule.conventions[1].Attribute != "label" {
+		t.Fatalf("Expected attribute label, got %s", rule.conventions[1].Attribute)
+	}
+}
@@ -0,0 +1,35 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ruleWithSeverity emits a rule's issues with a different severity to the rule's own.
+type ruleWithSeverity struct {
+	tflint.Rule
+	severity tflint.Severity
+}
+
+func (r *ruleWithSeverity) Severity() tflint.Severity {
+	return r.severity
+}
+
+// withSeverity returns the rule to emit issues with, given a severity from the rule's configuration.
+// An empty severity leaves the rule's own severity in place.
+func withSeverity(rule tflint.Rule, severity string) (tflint.Rule, error) {
+	switch strings.ToLower(severity) {
+	case "":
+		return rule, nil
+	case "error":
+		return &ruleWithSeverity{Rule: rule, severity: tflint.ERROR}, nil
+	case "warning":
+		return &ruleWithSeverity{Rule: rule, severity: tflint.WARNING}, nil
+	case "notice":
+		return &ruleWithSeverity{Rule: rule, severity: tflint.NOTICE}, nil
+	default:
+		return nil, fmt.Errorf("invalid severity %q for %s rule: must be error, warning or notice", severity, rule.Name())
+	}
+}